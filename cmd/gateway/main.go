@@ -15,9 +15,11 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/isa-cloud/isa_cloud/internal/gateway"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
 	"github.com/isa-cloud/isa_cloud/internal/config"
 	"github.com/isa-cloud/isa_cloud/pkg/logger"
 )
@@ -61,7 +63,24 @@ func runGateway(cmd *cobra.Command, args []string) {
 	}
 
 	// Initialize logger
-	logger := logger.New(cfg.Logging.Level, cfg.Debug)
+	logger, err := logger.NewWithOptions(logger.Options{
+		Level:  cfg.Logging.Level,
+		Format: cfg.Logging.Format,
+		Output: cfg.Logging.Output,
+		File:   cfg.Logging.File,
+		Rotation: logger.Rotation{
+			MaxSizeMB:  cfg.Logging.Rotation.MaxSizeMB,
+			MaxBackups: cfg.Logging.Rotation.MaxBackups,
+			MaxAgeDays: cfg.Logging.Rotation.MaxAgeDays,
+			Interval:   cfg.Logging.Rotation.Interval,
+			Compress:   cfg.Logging.Rotation.Compress,
+		},
+		Debug: cfg.Debug,
+	})
+	if err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+	defer logger.Close()
 	logger.Info("Starting IsA Cloud Gateway", 
 		"version", cfg.App.Version,
 		"environment", cfg.Environment,
@@ -94,6 +113,16 @@ func runGateway(cmd *cobra.Command, args []string) {
 		}
 	}()
 
+	// Start metrics server
+	if cfg.Monitoring.Enabled {
+		go func() {
+			if err := startMetricsServer(ctx, gw, cfg, logger); err != nil {
+				logger.Error("Metrics server failed", "error", err)
+				cancel()
+			}
+		}()
+	}
+
 	// Wait for shutdown signal
 	shutdownCh := make(chan os.Signal, 1)
 	signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
@@ -124,21 +153,34 @@ func startGRPCServer(ctx context.Context, gw *gateway.Gateway, cfg *config.Confi
 		return fmt.Errorf("failed to listen on %s: %w", addr, err)
 	}
 
-	// Create gRPC server with interceptors
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(gw.GRPCUnaryInterceptor()),
-		grpc.StreamInterceptor(gw.GRPCStreamInterceptor()),
-	)
+	// Create gRPC server with interceptors; tracing runs first so the span
+	// covers authentication and logging
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(), gw.GRPCUnaryInterceptor()),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(), gw.GRPCStreamInterceptor()),
+	}
+	tlsConfig, err := gw.TLSConfig("h2")
+	if err != nil {
+		lis.Close()
+		return fmt.Errorf("invalid gRPC TLS configuration: %w", err)
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcServer := grpc.NewServer(opts...)
 
 	// Register services
-	gw.RegisterGRPCServices(grpcServer)
+	if err := gw.RegisterGRPCServices(grpcServer); err != nil {
+		lis.Close()
+		return err
+	}
 
 	// Enable reflection for development
 	if cfg.Debug {
 		reflection.Register(grpcServer)
 	}
 
-	logger.Info("Starting gRPC server", "address", addr)
+	logger.Info("Starting gRPC server", "address", addr, "tls", tlsConfig != nil)
 
 	// Graceful shutdown
 	go func() {
@@ -168,7 +210,13 @@ func startHTTPServer(ctx context.Context, gw *gateway.Gateway, cfg *config.Confi
 		IdleTimeout:  60 * time.Second,
 	}
 
-	logger.Info("Starting HTTP server", "address", addr)
+	tlsConfig, err := gw.TLSConfig("h2", "http/1.1")
+	if err != nil {
+		return fmt.Errorf("invalid HTTP TLS configuration: %w", err)
+	}
+	server.TLSConfig = tlsConfig
+
+	logger.Info("Starting HTTP server", "address", addr, "tls", tlsConfig != nil)
 
 	// Graceful shutdown
 	go func() {
@@ -182,9 +230,52 @@ func startHTTPServer(ctx context.Context, gw *gateway.Gateway, cfg *config.Confi
 		}
 	}()
 
-	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+	if tlsConfig != nil {
+		// Certificates come from the TLS configuration
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("HTTP server failed: %w", err)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+func startMetricsServer(ctx context.Context, gw *gateway.Gateway, cfg *config.Config, logger *logger.Logger) error {
+	path := cfg.Monitoring.Path
+	if path == "" {
+		path = "/metrics"
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, gw.MetricsHandler())
+
+	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Monitoring.Port)
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+
+	logger.Info("Starting metrics server", "address", addr, "path", path)
+
+	// Graceful shutdown
+	go func() {
+		<-ctx.Done()
+		logger.Info("Stopping metrics server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error("Metrics server shutdown failed", "error", err)
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("metrics server failed: %w", err)
+	}
+
+	return nil
+}
@@ -15,8 +15,12 @@ type Config struct {
 	Debug             bool                  `mapstructure:"debug"`
 	Server            ServerConfig          `mapstructure:"server"`
 	Services          ServicesConfig        `mapstructure:"services"`
+	Discovery         DiscoveryConfig       `mapstructure:"discovery"`
+	Proxy             ProxyConfig           `mapstructure:"proxy"`
+	Routes            []RouteConfig         `mapstructure:"routes"`
 	Database          DatabaseConfig        `mapstructure:"database"`
 	Redis             RedisConfig           `mapstructure:"redis"`
+	EventBus          EventBusConfig        `mapstructure:"event_bus"`
 	Logging           LoggingConfig         `mapstructure:"logging"`
 	Monitoring        MonitoringConfig      `mapstructure:"monitoring"`
 	Security          SecurityConfig        `mapstructure:"security"`
@@ -36,6 +40,28 @@ type ServerConfig struct {
 	Host     string `mapstructure:"host"`
 	HTTPPort int    `mapstructure:"http_port"`
 	GRPCPort int    `mapstructure:"grpc_port"`
+	TLS      ServerTLSConfig `mapstructure:"tls"`
+	// Proxies (IPs or CIDRs) whose X-Forwarded-For / X-Real-IP headers are
+	// trusted when computing the client IP; empty trusts none
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+}
+
+// ServerTLSConfig contains TLS settings shared by the HTTP and gRPC listeners
+type ServerTLSConfig struct {
+	Enabled          bool                   `mapstructure:"enabled"`
+	CertFile         string                 `mapstructure:"cert_file"`
+	KeyFile          string                 `mapstructure:"key_file"`
+	ClientCAFile     string                 `mapstructure:"client_ca_file"`    // verifies client certificates
+	ClientAuth       string                 `mapstructure:"client_auth"`       // none, optional or require
+	MinVersion       string                 `mapstructure:"min_version"`       // 1.2 or 1.3
+	ReloadInterval   time.Duration          `mapstructure:"reload_interval"`   // how often certificate files are checked for changes
+	ClientIdentities []ClientIdentityConfig `mapstructure:"client_identities"` // client certificates trusted as internal services
+}
+
+// ClientIdentityConfig maps a verified client certificate to an internal service
+type ClientIdentityConfig struct {
+	Subject string `mapstructure:"subject"` // URI SAN, DNS SAN or common name
+	Service string `mapstructure:"service"`
 }
 
 // ServicesConfig contains external services configuration
@@ -49,17 +75,136 @@ type ServicesConfig struct {
 
 // ServiceEndpoint represents a service endpoint configuration
 type ServiceEndpoint struct {
-	Host     string        `mapstructure:"host"`
-	HTTPPort int           `mapstructure:"http_port"`
-	GRPCPort int           `mapstructure:"grpc_port"`
-	Timeout  time.Duration `mapstructure:"timeout"`
-	Retry    RetryConfig   `mapstructure:"retry"`
+	Host          string        `mapstructure:"host"`
+	HTTPPort      int           `mapstructure:"http_port"`
+	GRPCPort      int           `mapstructure:"grpc_port"`      // static gRPC backend; calls are not discovered, balanced, broken or retried
+	Timeout       time.Duration `mapstructure:"timeout"`        // deadline for regular requests
+	StreamTimeout time.Duration `mapstructure:"stream_timeout"` // deadline for SSE streams
+	Retry         RetryConfig   `mapstructure:"retry"`
+	Cancel        CancelConfig  `mapstructure:"cancel"`
+}
+
+// CancelConfig describes an upstream endpoint notified when a client abandons a stream
+type CancelConfig struct {
+	Path    string        `mapstructure:"path"` // template; may reference route parameters, {request_id} and {stream_id}
+	Method  string        `mapstructure:"method"`
+	Timeout time.Duration `mapstructure:"timeout"`
 }
 
 // RetryConfig contains retry configuration
 type RetryConfig struct {
 	MaxAttempts int           `mapstructure:"max_attempts"`
-	Backoff     time.Duration `mapstructure:"backoff"`
+	Backoff     time.Duration `mapstructure:"backoff"`     // base delay, doubled on every attempt
+	MaxBackoff  time.Duration `mapstructure:"max_backoff"` // upper bound for a single delay
+	BudgetRatio float64       `mapstructure:"budget_ratio"` // retries allowed as a fraction of requests
+	BudgetMin   int           `mapstructure:"budget_min"`   // retries always allowed per budget window
+}
+
+// DiscoveryConfig contains service discovery configuration
+type DiscoveryConfig struct {
+	LoadBalancing LoadBalancingConfig `mapstructure:"load_balancing"`
+	Catalog       CatalogConfig       `mapstructure:"catalog"`
+}
+
+// CatalogConfig contains settings for the local, watch-driven service catalog
+type CatalogConfig struct {
+	WaitTime            time.Duration `mapstructure:"wait_time"`             // Consul blocking query wait time
+	RetryInterval       time.Duration `mapstructure:"retry_interval"`        // delay between watch attempts while Consul is unreachable
+	InitialFetchTimeout time.Duration `mapstructure:"initial_fetch_timeout"` // how long a first lookup waits for catalog data
+	IdleTimeout         time.Duration `mapstructure:"idle_timeout"`          // an unsubscribed service watch stops after this long without lookups
+}
+
+// LoadBalancingConfig contains instance selection configuration
+type LoadBalancingConfig struct {
+	Strategy      string            `mapstructure:"strategy"`        // round_robin, least_request, weighted, p2c
+	Services      map[string]string `mapstructure:"services"`        // per-service strategy overrides
+	WeightMetaKey string            `mapstructure:"weight_meta_key"` // Consul service meta key holding the instance weight
+}
+
+// RouteConfig describes one entry of the declarative route table. Routes are
+// matched in order and the first match wins
+type RouteConfig struct {
+	Name         string            `mapstructure:"name"`
+	Path         string            `mapstructure:"path"`          // template: literals, {param}, * and a trailing **
+	Methods      []string          `mapstructure:"methods"`       // empty matches every method
+	Headers      map[string]string `mapstructure:"headers"`       // required header values ("*" only requires presence)
+	Service      string            `mapstructure:"service"`       // target service, may reference path parameters
+	StripPrefix  string            `mapstructure:"strip_prefix"`  // prefix removed before forwarding
+	Rewrite      string            `mapstructure:"rewrite"`       // upstream path template, may reference {param} and {**}
+	AuthRequired bool              `mapstructure:"auth_required"` // run gateway authentication before proxying
+	Timeout      time.Duration     `mapstructure:"timeout"`       // overrides the service timeout
+	Streaming    bool              `mapstructure:"streaming"`     // proxy as SSE regardless of instance tags
+}
+
+// ProxyConfig contains upstream proxying configuration
+type ProxyConfig struct {
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+	Transport      TransportConfig      `mapstructure:"transport"`
+	WebSocket      WebSocketConfig      `mapstructure:"websocket"`
+	SSE            SSEConfig            `mapstructure:"sse"`
+}
+
+// SSEConfig contains server-sent event streaming configuration
+type SSEConfig struct {
+	KeepaliveInterval time.Duration `mapstructure:"keepalive_interval"` // comment sent on quiet streams (0 disables)
+	ReplayEvents      int           `mapstructure:"replay_events"`      // events buffered per stream for resumption (0 disables)
+	ReplayMaxBytes    int           `mapstructure:"replay_max_bytes"`   // bytes buffered per stream; the oldest events are dropped beyond it
+	ReplayTTL         time.Duration `mapstructure:"replay_ttl"`         // how long a buffer outlives its last event, and an abandoned stream its client
+	MaxReplayStreams  int           `mapstructure:"max_replay_streams"`
+	StreamIDHeader    string        `mapstructure:"stream_id_header"` // client header naming the stream to buffer
+	MaxEventSize      int           `mapstructure:"max_event_size"`   // largest single upstream event, in bytes
+}
+
+// WebSocketConfig contains WebSocket proxying configuration
+type WebSocketConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`
+	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"` // upstream dial and handshake deadline
+	IdleTimeout      time.Duration `mapstructure:"idle_timeout"`      // close when no frames flow in either direction
+	WriteTimeout     time.Duration `mapstructure:"write_timeout"`     // deadline for relaying a single frame
+	MaxMessageSize   int64         `mapstructure:"max_message_size"`  // largest message accepted from either side, in bytes
+}
+
+// TransportConfig contains connection pooling settings for upstream instances.
+// Every instance gets its own transport, so per-host limits apply per instance
+type TransportConfig struct {
+	MaxIdleConns        int           `mapstructure:"max_idle_conns"`          // idle connections kept per instance (0 = unlimited)
+	MaxIdleConnsPerHost int           `mapstructure:"max_idle_conns_per_host"` // idle connections kept per upstream host
+	MaxConnsPerHost     int           `mapstructure:"max_conns_per_host"`      // total connections per upstream host (0 = unlimited)
+	IdleConnTimeout     time.Duration `mapstructure:"idle_conn_timeout"`
+	DialTimeout         time.Duration `mapstructure:"dial_timeout"`
+	KeepAlive           time.Duration `mapstructure:"keep_alive"`
+	TLSHandshakeTimeout time.Duration `mapstructure:"tls_handshake_timeout"`
+	HTTP2               bool          `mapstructure:"http2"` // negotiate HTTP/2 with TLS upstreams
+	H2C                 bool          `mapstructure:"h2c"`   // use cleartext HTTP/2 with prior knowledge
+	TLS                 UpstreamTLSConfig `mapstructure:"tls"`
+}
+
+// UpstreamTLSConfig contains TLS settings for connections to backends
+type UpstreamTLSConfig struct {
+	Enabled            bool          `mapstructure:"enabled"`              // connect to backends over https
+	CAFile             string        `mapstructure:"ca_file"`              // verifies backend certificates (system roots if empty)
+	CertFile           string        `mapstructure:"cert_file"`            // client certificate for mTLS
+	KeyFile            string        `mapstructure:"key_file"`
+	ServerName         string        `mapstructure:"server_name"`          // expected backend name (defaults to the host)
+	InsecureSkipVerify bool          `mapstructure:"insecure_skip_verify"` // development only
+	ReloadInterval     time.Duration `mapstructure:"reload_interval"`
+}
+
+// CircuitBreakerConfig contains circuit breaker and outlier ejection configuration
+type CircuitBreakerConfig struct {
+	Enabled                bool                              `mapstructure:"enabled"`
+	CircuitBreakerSettings `mapstructure:",squash"`
+	Services               map[string]CircuitBreakerSettings `mapstructure:"services"` // per-service overrides (zero values inherit)
+}
+
+// CircuitBreakerSettings contains the thresholds of a circuit breaker
+type CircuitBreakerSettings struct {
+	ErrorRateThreshold float64       `mapstructure:"error_rate_threshold"` // failure ratio that opens the breaker
+	LatencyThreshold   time.Duration `mapstructure:"latency_threshold"`    // slower calls count as failures (0 disables)
+	MinRequests        int           `mapstructure:"min_requests"`         // calls required in the window before tripping
+	Window             time.Duration `mapstructure:"window"`               // rolling window for failure accounting
+	OpenDuration       time.Duration `mapstructure:"open_duration"`        // how long an open breaker rejects calls
+	HalfOpenRequests   int           `mapstructure:"half_open_requests"`   // probe calls allowed while half-open
 }
 
 // DatabaseConfig contains database configuration
@@ -80,12 +225,53 @@ type RedisConfig struct {
 	Database int    `mapstructure:"database"`
 }
 
+// EventBusConfig contains NATS event bus configuration
+type EventBusConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	URL      string `mapstructure:"url"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	ClientID string `mapstructure:"client_id"`
+}
+
 // LoggingConfig contains logging configuration
 type LoggingConfig struct {
-	Level  string `mapstructure:"level"`
-	Format string `mapstructure:"format"` // json, text
-	Output string `mapstructure:"output"` // stdout, file
-	File   string `mapstructure:"file"`
+	Level    string            `mapstructure:"level"`
+	Format   string            `mapstructure:"format"` // json, text
+	Output   string            `mapstructure:"output"` // stdout, file, both
+	File     string            `mapstructure:"file"`
+	Rotation LogRotationConfig `mapstructure:"rotation"`
+	Access   AccessLogConfig   `mapstructure:"access"`
+}
+
+// LogRotationConfig limits log files by size and age
+type LogRotationConfig struct {
+	MaxSizeMB  int           `mapstructure:"max_size_mb"`
+	MaxBackups int           `mapstructure:"max_backups"`
+	MaxAgeDays int           `mapstructure:"max_age_days"`
+	Interval   time.Duration `mapstructure:"interval"` // also rotate on this interval; 0 rotates by size only
+	Compress   bool          `mapstructure:"compress"`
+}
+
+// AccessLogConfig configures the access log, one entry per HTTP request
+// written separately from the application log
+type AccessLogConfig struct {
+	Enabled       bool                      `mapstructure:"enabled"`
+	Format        string                    `mapstructure:"format"` // json or combined
+	Output        string                    `mapstructure:"output"` // stdout, file, both
+	File          string                    `mapstructure:"file"`
+	Rotation      LogRotationConfig         `mapstructure:"rotation"`
+	Headers       []string                  `mapstructure:"headers"`        // request headers to record; combined uses Referer
+	RedactHeaders []string                  `mapstructure:"redact_headers"` // recorded as [REDACTED]
+	RedactQuery   []string                  `mapstructure:"redact_query"`   // query parameters recorded as [REDACTED]
+	Sampling      []AccessLogSamplingConfig `mapstructure:"sampling"`
+}
+
+// AccessLogSamplingConfig logs a share of the requests under a path prefix.
+// Failed requests (status >= 400) are always logged
+type AccessLogSamplingConfig struct {
+	PathPrefix string  `mapstructure:"path_prefix"`
+	Rate       float64 `mapstructure:"rate"` // 0 to 1
 }
 
 // MonitoringConfig contains monitoring configuration
@@ -95,6 +281,19 @@ type MonitoringConfig struct {
 	Path       string `mapstructure:"path"`
 	Namespace  string `mapstructure:"namespace"`
 	Subsystem  string `mapstructure:"subsystem"`
+	Tracing    TracingConfig `mapstructure:"tracing"`
+}
+
+// TracingConfig contains OpenTelemetry tracing configuration. Trace context
+// is propagated with W3C headers even when export is disabled
+type TracingConfig struct {
+	Enabled     bool              `mapstructure:"enabled"`
+	Exporter    string            `mapstructure:"exporter"`     // otlp or stdout
+	Endpoint    string            `mapstructure:"endpoint"`     // OTLP/HTTP collector host:port
+	Insecure    bool              `mapstructure:"insecure"`     // plain HTTP to the collector
+	Headers     map[string]string `mapstructure:"headers"`      // e.g. collector API keys
+	ServiceName string            `mapstructure:"service_name"`
+	SampleRatio float64           `mapstructure:"sample_ratio"` // of new traces; sampled parents are always followed
 }
 
 // SecurityConfig contains security configuration
@@ -102,6 +301,107 @@ type SecurityConfig struct {
 	CORS        CORSConfig `mapstructure:"cors"`
 	RateLimit   RateLimitConfig `mapstructure:"rate_limit"`
 	JWT         JWTConfig `mapstructure:"jwt"`
+	AuthCache   AuthCacheConfig `mapstructure:"auth_cache"`
+	ServiceAuth ServiceAuthConfig `mapstructure:"service_auth"`
+	Authorization AuthorizationConfig `mapstructure:"authorization"`
+	AuthProfile AuthProfileConfig `mapstructure:"auth_profile"`
+	IdentityForwarding IdentityForwardingConfig `mapstructure:"identity_forwarding"`
+	Audit       AuditConfig `mapstructure:"audit"`
+}
+
+// AuditConfig contains the security audit events published to the event bus
+type AuditConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`
+	QueueSize        int           `mapstructure:"queue_size"` // events waiting for the event bus; more are dropped
+	PublishTimeout   time.Duration `mapstructure:"publish_timeout"`
+	InternalServices bool          `mapstructure:"internal_services"` // also audit successful internal service authentication
+}
+
+// IdentityForwardingConfig contains the signed identity header sent to
+// backends after authentication. Client-supplied copies are always removed
+type IdentityForwardingConfig struct {
+	Enabled    bool          `mapstructure:"enabled"`
+	Header     string        `mapstructure:"header"`
+	KeyID      string        `mapstructure:"key_id"` // sent as kid to allow secret rotation
+	Secret     string        `mapstructure:"secret"`
+	SecretFile string        `mapstructure:"secret_file"`
+	TTL        time.Duration `mapstructure:"ttl"`
+}
+
+// AuthProfileConfig selects the authentication profile. The development
+// profile lets loopback clients with a known tool User-Agent in as an internal
+// service without credentials; it must never be enabled in production
+type AuthProfileConfig struct {
+	Environment   string   `mapstructure:"environment"`     // production (default) or development
+	DevUserAgents []string `mapstructure:"dev_user_agents"` // User-Agent substrings accepted by the development bypass
+}
+
+// AuthorizationConfig controls what happens when the Authorization Service
+// cannot answer a permission check
+type AuthorizationConfig struct {
+	DefaultPolicy string                      `mapstructure:"default_policy"` // fail_open, fail_closed or degraded
+	StaleGrace    time.Duration               `mapstructure:"stale_grace"`    // how long past decision_ttl a cached decision may still be served
+	DegradedLevel string                      `mapstructure:"degraded_level"` // highest access level granted by the degraded policy
+	Policies      []AuthorizationPolicyConfig `mapstructure:"policies"`       // per-route overrides, longest prefix wins
+	RulesFile     string                      `mapstructure:"rules_file"`     // maps requests to permission checks; empty uses the built-in rules
+}
+
+// AuthorizationRulesFile is the layout of security.authorization.rules_file
+type AuthorizationRulesFile struct {
+	Rules []AuthorizationRuleConfig `mapstructure:"rules"`
+}
+
+// AuthorizationRuleConfig maps requests to the permission checked with the
+// Authorization Service. Rules are evaluated in order and the first match wins
+type AuthorizationRuleConfig struct {
+	Name          string   `mapstructure:"name"`
+	Path          string   `mapstructure:"path"`           // template: literal segments, {param} for one segment, {param...} for any number
+	Methods       []string `mapstructure:"methods"`        // empty matches every method
+	ResourceType  string   `mapstructure:"resource_type"`
+	ResourceName  string   `mapstructure:"resource_name"`  // may reference path parameters as {param}
+	RequiredLevel string   `mapstructure:"required_level"` // read_only, read_write, admin or owner
+}
+
+// AuthorizationPolicyConfig sets the failure policy for a route prefix. The
+// prefix matches whole path segments and may use rule template parameters
+type AuthorizationPolicyConfig struct {
+	PathPrefix string `mapstructure:"path_prefix"`
+	Policy     string `mapstructure:"policy"`
+}
+
+// ServiceAuthConfig contains HMAC request signing for internal services
+type ServiceAuthConfig struct {
+	MaxClockSkew      time.Duration             `mapstructure:"max_clock_skew"`     // accepted timestamp drift; nonces are kept twice as long
+	MaxBodySize       int64                     `mapstructure:"max_body_size"`      // larger bodies are rejected
+	RequireRegistered bool                      `mapstructure:"require_registered"` // the service must also be registered in Consul
+	Services          []ServiceCredentialConfig `mapstructure:"services"`
+}
+
+// ServiceCredentialConfig holds the signing keys of one internal service.
+// Several keys may be active at once to rotate secrets without downtime
+type ServiceCredentialConfig struct {
+	Name string             `mapstructure:"name"`
+	Keys []ServiceKeyConfig `mapstructure:"keys"`
+}
+
+// ServiceKeyConfig is one signing secret, given inline or read from a file
+type ServiceKeyConfig struct {
+	ID         string `mapstructure:"id"`
+	Secret     string `mapstructure:"secret"`
+	SecretFile string `mapstructure:"secret_file"`
+}
+
+// AuthCacheConfig contains the verification cache for tokens, API keys and
+// authorization decisions
+type AuthCacheConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	Backend     string        `mapstructure:"backend"`      // memory or redis
+	TokenTTL    time.Duration `mapstructure:"token_ttl"`    // capped by the token's expiry
+	APIKeyTTL   time.Duration `mapstructure:"api_key_ttl"`
+	DecisionTTL time.Duration `mapstructure:"decision_ttl"` // authorization check-access results
+	NegativeTTL time.Duration `mapstructure:"negative_ttl"` // invalid tokens and API keys
+	MaxEntries  int           `mapstructure:"max_entries"`  // memory backend only
+	KeyPrefix   string        `mapstructure:"key_prefix"`   // redis backend only
 }
 
 // CORSConfig contains CORS configuration
@@ -113,11 +413,35 @@ type CORSConfig struct {
 	AllowCredentials bool     `mapstructure:"allow_credentials"`
 }
 
-// RateLimitConfig contains rate limiting configuration
+// RateLimitConfig contains rate limiting configuration. Every caller gets its
+// own token bucket; limits come from the matching route, then the caller's
+// subscription tier, then the defaults
 type RateLimitConfig struct {
 	Enabled bool `mapstructure:"enabled"`
 	RPS     int  `mapstructure:"rps"`     // requests per second
 	Burst   int  `mapstructure:"burst"`   // burst size
+	KeyBy   string                     `mapstructure:"key_by"`   // identity (API key or user, else IP), org or ip
+	IdleTTL time.Duration              `mapstructure:"idle_ttl"` // buckets unused this long are evicted
+	Backend string                     `mapstructure:"backend"`    // memory (per replica) or redis (shared, local fallback)
+	KeyPrefix string                   `mapstructure:"key_prefix"` // redis backend only
+	Tiers   map[string]RateLimitValues `mapstructure:"tiers"`    // limits by subscription_tier
+	Routes  []RouteRateLimitConfig     `mapstructure:"routes"`   // per-route limits, longest prefix wins
+}
+
+// RateLimitValues is a token bucket size and refill rate
+type RateLimitValues struct {
+	RPS   int `mapstructure:"rps"`
+	Burst int `mapstructure:"burst"`
+}
+
+// RouteRateLimitConfig limits the routes under a path prefix. Each route
+// limit has its own buckets, separate from the default ones
+type RouteRateLimitConfig struct {
+	PathPrefix string                     `mapstructure:"path_prefix"`
+	RPS        int                        `mapstructure:"rps"`    // 0 uses the tier or default limit
+	Burst      int                        `mapstructure:"burst"`
+	KeyBy      string                     `mapstructure:"key_by"` // defaults to the global key_by
+	Tiers      map[string]RateLimitValues `mapstructure:"tiers"`  // per-tier limits for this route
 }
 
 // JWTConfig contains JWT configuration
@@ -125,6 +449,23 @@ type JWTConfig struct {
 	Secret     string        `mapstructure:"secret"`
 	Expiration time.Duration `mapstructure:"expiration"`
 	Issuer     string        `mapstructure:"issuer"`
+
+	// Local verification of tokens from trusted issuers; opaque tokens and
+	// other issuers are still verified by the Auth Service
+	LocalVerification bool           `mapstructure:"local_verification"`
+	TrustedIssuers    []string       `mapstructure:"trusted_issuers"` // defaults to issuer
+	Audience          []string       `mapstructure:"audience"`        // accepted aud values (empty skips the check)
+	Algorithms        []string       `mapstructure:"algorithms"`      // RS256, ES256 and/or HS256 (HS256 uses secret)
+	JWKSURL           string         `mapstructure:"jwks_url"`
+	JWKSRefresh       time.Duration  `mapstructure:"jwks_refresh"` // periodic key set refresh
+	PublicKeys        []JWTKeyConfig `mapstructure:"public_keys"`  // static PEM keys
+	ClockSkew         time.Duration  `mapstructure:"clock_skew"`   // leeway for exp and nbf
+}
+
+// JWTKeyConfig is a static JWT verification key
+type JWTKeyConfig struct {
+	KeyID string `mapstructure:"kid"`  // matched against the token header; empty matches any kid
+	File  string `mapstructure:"file"` // PEM public key or certificate
 }
 
 // MQTTConfig contains MQTT broker configuration
@@ -199,6 +540,21 @@ func Load(configFile string) (*Config, error) {
 	return &cfg, nil
 }
 
+// LoadAuthorizationRules reads an authorization rules file (YAML or JSON)
+func LoadAuthorizationRules(file string) ([]AuthorizationRuleConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read authorization rules %s: %w", file, err)
+	}
+
+	var rules AuthorizationRulesFile
+	if err := v.Unmarshal(&rules); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal authorization rules %s: %w", file, err)
+	}
+	return rules.Rules, nil
+}
+
 // setDefaults sets default configuration values
 func setDefaults() {
 	// App
@@ -213,6 +569,11 @@ func setDefaults() {
 	viper.SetDefault("server.host", "0.0.0.0")
 	viper.SetDefault("server.http_port", 8000)
 	viper.SetDefault("server.grpc_port", 9000)
+	viper.SetDefault("server.tls.enabled", false)
+	viper.SetDefault("server.tls.client_auth", "optional")
+	viper.SetDefault("server.tls.min_version", "1.2")
+	viper.SetDefault("server.tls.reload_interval", "30s")
+	viper.SetDefault("server.trusted_proxies", []string{})
 
 	// Services
 	viper.SetDefault("services.user_service.host", "localhost")
@@ -221,6 +582,7 @@ func setDefaults() {
 	viper.SetDefault("services.user_service.timeout", "30s")
 	viper.SetDefault("services.user_service.retry.max_attempts", 3)
 	viper.SetDefault("services.user_service.retry.backoff", "1s")
+	viper.SetDefault("services.user_service.retry.max_backoff", "10s")
 
 	viper.SetDefault("services.auth_service.host", "localhost")
 	viper.SetDefault("services.auth_service.http_port", 8101)
@@ -231,16 +593,60 @@ func setDefaults() {
 	viper.SetDefault("services.agent_service.http_port", 8080)
 	viper.SetDefault("services.agent_service.grpc_port", 9080)
 	viper.SetDefault("services.agent_service.timeout", "60s")
+	viper.SetDefault("services.agent_service.stream_timeout", "30m")
 
 	viper.SetDefault("services.model_service.host", "localhost")
 	viper.SetDefault("services.model_service.http_port", 8082)
 	viper.SetDefault("services.model_service.grpc_port", 9082)
 	viper.SetDefault("services.model_service.timeout", "120s")
+	viper.SetDefault("services.model_service.stream_timeout", "30m")
 
 	viper.SetDefault("services.mcp_service.host", "localhost")
 	viper.SetDefault("services.mcp_service.http_port", 8081)
 	viper.SetDefault("services.mcp_service.grpc_port", 9081)
 	viper.SetDefault("services.mcp_service.timeout", "30s")
+	viper.SetDefault("services.mcp_service.stream_timeout", "30m")
+
+	// Discovery
+	viper.SetDefault("discovery.load_balancing.strategy", "round_robin")
+	viper.SetDefault("discovery.load_balancing.weight_meta_key", "weight")
+	viper.SetDefault("discovery.catalog.wait_time", "5m")
+	viper.SetDefault("discovery.catalog.retry_interval", "2s")
+	viper.SetDefault("discovery.catalog.initial_fetch_timeout", "5s")
+	viper.SetDefault("discovery.catalog.idle_timeout", "10m")
+
+	// Proxy
+	viper.SetDefault("proxy.circuit_breaker.enabled", true)
+	viper.SetDefault("proxy.circuit_breaker.error_rate_threshold", 0.5)
+	viper.SetDefault("proxy.circuit_breaker.latency_threshold", "0s")
+	viper.SetDefault("proxy.circuit_breaker.min_requests", 20)
+	viper.SetDefault("proxy.circuit_breaker.window", "30s")
+	viper.SetDefault("proxy.circuit_breaker.open_duration", "30s")
+	viper.SetDefault("proxy.circuit_breaker.half_open_requests", 3)
+	viper.SetDefault("proxy.transport.max_idle_conns", 64)
+	viper.SetDefault("proxy.transport.max_idle_conns_per_host", 32)
+	viper.SetDefault("proxy.transport.max_conns_per_host", 0)
+	viper.SetDefault("proxy.transport.idle_conn_timeout", "90s")
+	viper.SetDefault("proxy.transport.dial_timeout", "10s")
+	viper.SetDefault("proxy.transport.keep_alive", "30s")
+	viper.SetDefault("proxy.transport.tls_handshake_timeout", "10s")
+	viper.SetDefault("proxy.transport.http2", true)
+	viper.SetDefault("proxy.transport.h2c", false)
+	viper.SetDefault("proxy.websocket.enabled", true)
+	viper.SetDefault("proxy.websocket.handshake_timeout", "10s")
+	viper.SetDefault("proxy.websocket.idle_timeout", "5m")
+	viper.SetDefault("proxy.websocket.write_timeout", "10s")
+	viper.SetDefault("proxy.websocket.max_message_size", 1048576)
+	viper.SetDefault("proxy.sse.keepalive_interval", "15s")
+	viper.SetDefault("proxy.sse.replay_events", 0)
+	viper.SetDefault("proxy.sse.replay_max_bytes", 1048576)
+	viper.SetDefault("proxy.sse.replay_ttl", "5m")
+	viper.SetDefault("proxy.sse.max_replay_streams", 1000)
+	viper.SetDefault("proxy.sse.stream_id_header", "X-Stream-ID")
+	viper.SetDefault("proxy.sse.max_event_size", 16777216)
+
+	viper.SetDefault("proxy.transport.tls.enabled", false)
+	viper.SetDefault("proxy.transport.tls.reload_interval", "30s")
 
 	// Database
 	viper.SetDefault("database.host", "localhost")
@@ -256,10 +662,31 @@ func setDefaults() {
 	viper.SetDefault("redis.password", "")
 	viper.SetDefault("redis.database", 0)
 
+	// Event bus
+	viper.SetDefault("event_bus.enabled", false)
+	viper.SetDefault("event_bus.url", "nats://localhost:4222")
+	viper.SetDefault("event_bus.client_id", "isa_cloud_gateway")
+
 	// Logging
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.format", "json")
 	viper.SetDefault("logging.output", "stdout")
+	viper.SetDefault("logging.rotation.max_size_mb", 100)
+	viper.SetDefault("logging.rotation.max_backups", 10)
+	viper.SetDefault("logging.rotation.max_age_days", 14)
+	viper.SetDefault("logging.rotation.interval", "0s")
+	viper.SetDefault("logging.rotation.compress", true)
+	viper.SetDefault("logging.access.enabled", true)
+	viper.SetDefault("logging.access.format", "json")
+	viper.SetDefault("logging.access.output", "stdout")
+	viper.SetDefault("logging.access.rotation.max_size_mb", 100)
+	viper.SetDefault("logging.access.rotation.max_backups", 10)
+	viper.SetDefault("logging.access.rotation.max_age_days", 14)
+	viper.SetDefault("logging.access.rotation.interval", "24h")
+	viper.SetDefault("logging.access.rotation.compress", true)
+	viper.SetDefault("logging.access.headers", []string{"Referer", "X-Forwarded-For"})
+	viper.SetDefault("logging.access.redact_headers", []string{"Authorization", "Proxy-Authorization", "X-API-Key", "Cookie", "X-Gateway-Identity"})
+	viper.SetDefault("logging.access.redact_query", []string{"api_key", "token", "access_token"})
 
 	// Monitoring
 	viper.SetDefault("monitoring.enabled", true)
@@ -267,6 +694,12 @@ func setDefaults() {
 	viper.SetDefault("monitoring.path", "/metrics")
 	viper.SetDefault("monitoring.namespace", "isa_cloud")
 	viper.SetDefault("monitoring.subsystem", "gateway")
+	viper.SetDefault("monitoring.tracing.enabled", false)
+	viper.SetDefault("monitoring.tracing.exporter", "otlp")
+	viper.SetDefault("monitoring.tracing.endpoint", "localhost:4318")
+	viper.SetDefault("monitoring.tracing.insecure", true)
+	viper.SetDefault("monitoring.tracing.service_name", "isa-cloud-gateway")
+	viper.SetDefault("monitoring.tracing.sample_ratio", 1.0)
 
 	// Security
 	viper.SetDefault("security.cors.enabled", true)
@@ -278,10 +711,47 @@ func setDefaults() {
 	viper.SetDefault("security.rate_limit.enabled", true)
 	viper.SetDefault("security.rate_limit.rps", 100)
 	viper.SetDefault("security.rate_limit.burst", 200)
+	viper.SetDefault("security.rate_limit.key_by", "identity")
+	viper.SetDefault("security.rate_limit.idle_ttl", "10m")
+	viper.SetDefault("security.rate_limit.backend", "memory")
+	viper.SetDefault("security.rate_limit.key_prefix", "isa_gateway:ratelimit:")
 
 	viper.SetDefault("security.jwt.secret", "your-secret-key")
 	viper.SetDefault("security.jwt.expiration", "24h")
 	viper.SetDefault("security.jwt.issuer", "isa-cloud")
+	viper.SetDefault("security.jwt.local_verification", false)
+	viper.SetDefault("security.jwt.algorithms", []string{"RS256", "ES256"})
+	viper.SetDefault("security.jwt.jwks_refresh", "15m")
+	viper.SetDefault("security.jwt.clock_skew", "30s")
+
+	viper.SetDefault("security.auth_cache.enabled", true)
+	viper.SetDefault("security.auth_cache.backend", "memory")
+	viper.SetDefault("security.auth_cache.token_ttl", "60s")
+	viper.SetDefault("security.auth_cache.api_key_ttl", "60s")
+	viper.SetDefault("security.auth_cache.decision_ttl", "30s")
+	viper.SetDefault("security.auth_cache.negative_ttl", "10s")
+	viper.SetDefault("security.auth_cache.max_entries", 10000)
+	viper.SetDefault("security.auth_cache.key_prefix", "isa_gateway:auth:")
+
+	viper.SetDefault("security.service_auth.max_clock_skew", "5m")
+	viper.SetDefault("security.service_auth.max_body_size", 10485760)
+	viper.SetDefault("security.service_auth.require_registered", false)
+
+	viper.SetDefault("security.authorization.default_policy", "fail_open")
+	viper.SetDefault("security.authorization.stale_grace", "5m")
+	viper.SetDefault("security.authorization.degraded_level", "read_only")
+
+	viper.SetDefault("security.identity_forwarding.enabled", false)
+	viper.SetDefault("security.identity_forwarding.header", "X-Gateway-Identity")
+	viper.SetDefault("security.identity_forwarding.ttl", "60s")
+
+	viper.SetDefault("security.audit.enabled", true)
+	viper.SetDefault("security.audit.queue_size", 1024)
+	viper.SetDefault("security.audit.publish_timeout", "5s")
+	viper.SetDefault("security.audit.internal_services", true)
+
+	viper.SetDefault("security.auth_profile.environment", "production")
+	viper.SetDefault("security.auth_profile.dev_user_agents", []string{"python-httpx", "axios", "node-fetch", "go-resty", "curl"})
 
 	// Blockchain (temporarily disabled)
 	// viper.SetDefault("blockchain.enabled", true)
@@ -9,13 +9,44 @@ import (
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/isa-cloud/isa_cloud/internal/eventbus")
+
+// headerCarrier carries trace context in NATS message headers. Keys are kept
+// as given, unlike http.Header, so every client sees "traceparent"
+type headerCarrier nats.Header
+
+func (h headerCarrier) Get(key string) string { return nats.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string) { nats.Header(h).Set(key, value) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for key := range h {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
 // EventBusClient handles all event-driven communication
 type EventBusClient struct {
 	nc     *nats.Conn
 	js     jetstream.JetStream
 	config *Config
+
+	// onPublish is told the outcome of every PublishEvent, e.g. for metrics
+	onPublish func(source, eventType string, err error)
 }
 
 // Config for EventBus client
@@ -143,8 +174,21 @@ func (c *EventBusClient) initializeStreams(ctx context.Context) error {
 	return nil
 }
 
+// SetPublishObserver sets a function told the outcome of every published event
+func (c *EventBusClient) SetPublishObserver(observer func(source, eventType string, err error)) {
+	c.onPublish = observer
+}
+
 // PublishEvent publishes a domain event
 func (c *EventBusClient) PublishEvent(ctx context.Context, event Event) error {
+	err := c.publishEvent(ctx, event)
+	if c.onPublish != nil {
+		c.onPublish(event.Source, event.Type, err)
+	}
+	return err
+}
+
+func (c *EventBusClient) publishEvent(ctx context.Context, event Event) (err error) {
 	// Set timestamp if not set
 	if event.Timestamp.IsZero() {
 		event.Timestamp = time.Now().UTC()
@@ -164,8 +208,22 @@ func (c *EventBusClient) PublishEvent(ctx context.Context, event Event) error {
 	// Construct subject
 	subject := fmt.Sprintf("events.%s.%s", event.Source, event.Type)
 
+	ctx, span := tracer.Start(ctx, "publish "+subject,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("messaging.destination.name", subject),
+			attribute.String("messaging.message.id", event.ID),
+		),
+	)
+	defer func() { endSpan(span, err) }()
+
+	// Consumers continue the trace from the message headers
+	msg := &nats.Msg{Subject: subject, Data: data, Header: nats.Header{}}
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier(msg.Header))
+
 	// Publish to JetStream
-	ack, err := c.js.PublishAsync(subject, data)
+	ack, err := c.js.PublishMsgAsync(msg)
 	if err != nil {
 		return fmt.Errorf("failed to publish event: %w", err)
 	}
@@ -175,8 +233,8 @@ func (c *EventBusClient) PublishEvent(ctx context.Context, event Event) error {
 	case <-ack.Ok():
 		log.Printf("Event published: %s [%s]", event.Type, event.ID)
 		return nil
-	case err := <-ack.Err():
-		return fmt.Errorf("event publish failed: %w", err)
+	case ackErr := <-ack.Err():
+		return fmt.Errorf("event publish failed: %w", ackErr)
 	case <-ctx.Done():
 		return ctx.Err()
 	}
@@ -190,6 +248,7 @@ func (c *EventBusClient) SubscribeToEvents(ctx context.Context, pattern string,
 		MaxDeliver:    3,
 		AckWait:       30 * time.Second,
 		AckPolicy:     jetstream.AckExplicitPolicy,
+		DeliverPolicy: jetstream.DeliverAllPolicy,
 	}
 
 	// Apply options
@@ -210,7 +269,7 @@ func (c *EventBusClient) SubscribeToEvents(ctx context.Context, pattern string,
 		MaxDeliver:    config.MaxDeliver,
 		AckWait:       config.AckWait,
 		AckPolicy:     config.AckPolicy,
-		DeliverPolicy: jetstream.DeliverAllPolicy,
+		DeliverPolicy: config.DeliverPolicy,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create consumer: %w", err)
@@ -225,8 +284,22 @@ func (c *EventBusClient) SubscribeToEvents(ctx context.Context, pattern string,
 			return
 		}
 
-		// Process event
-		if err := handler(ctx, event); err != nil {
+		// Process event in the trace of its publisher
+		handlerCtx := ctx
+		if headers := msg.Headers(); headers != nil {
+			handlerCtx = otel.GetTextMapPropagator().Extract(ctx, headerCarrier(headers))
+		}
+		handlerCtx, span := tracer.Start(handlerCtx, "process "+msg.Subject(),
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				attribute.String("messaging.system", "nats"),
+				attribute.String("messaging.destination.name", msg.Subject()),
+				attribute.String("messaging.message.id", event.ID),
+			),
+		)
+		err := handler(handlerCtx, event)
+		endSpan(span, err)
+		if err != nil {
 			log.Printf("Event handler error: %v", err)
 			msg.Nak()
 			return
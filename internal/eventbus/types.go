@@ -50,6 +50,7 @@ type ConsumerConfig struct {
 	MaxDeliver    int
 	AckWait       time.Duration
 	AckPolicy     jetstream.AckPolicy
+	DeliverPolicy jetstream.DeliverPolicy
 }
 
 // ConsumerOption is a function that modifies consumer configuration
@@ -72,6 +73,11 @@ const (
 	EventOrgMemberAdded     = "organization.member_added"
 	EventOrgMemberRemoved   = "organization.member_removed"
 	
+	// Credential and Permission Events
+	EventAPIKeyRevoked      = "auth.api_key_revoked"
+	EventTokenRevoked       = "auth.token_revoked"
+	EventPermissionsChanged = "authorization.permissions_changed"
+	
 	// Payment Events
 	EventPaymentInitiated   = "payment.initiated"
 	EventPaymentCompleted   = "payment.completed"
@@ -139,6 +145,7 @@ const (
 	SourceTaskService       = "task_service"
 	SourceNotificationService = "notification_service"
 	SourceAuditService      = "audit_service"
+	SourceAuthorizationService = "authorization_service"
 	SourceGateway           = "api_gateway"
 )
 
@@ -163,6 +170,13 @@ func WithAckWait(duration time.Duration) ConsumerOption {
 	}
 }
 
+// WithDeliverNew only delivers events published after the consumer is created
+func WithDeliverNew() ConsumerOption {
+	return func(c *ConsumerConfig) {
+		c.DeliverPolicy = jetstream.DeliverNewPolicy
+	}
+}
+
 // generateEventID generates a unique event ID
 func generateEventID() string {
 	return uuid.New().String()
@@ -0,0 +1,266 @@
+// Package audit publishes security audit events from the gateway to the event
+// bus. Records are queued locally and published in the background, so a slow
+// or unavailable event bus never delays requests; when the queue is full new
+// records are dropped and counted
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/internal/eventbus"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+// Audited actions
+const (
+	ActionAuthentication        = "authentication"
+	ActionServiceAuthentication = "internal_service_authentication"
+	ActionAuthorization         = "authorization"
+	ActionDeviceCommand         = "device_command"
+)
+
+// Decisions
+const (
+	DecisionAllow = "allow"
+	DecisionDeny  = "deny"
+)
+
+// Results reported to the observer
+const (
+	ResultPublished = "published"
+	ResultFailed    = "failed"
+	ResultDropped   = "dropped"
+)
+
+// Actor is who made the audited request
+type Actor struct {
+	ID             string `json:"id,omitempty"`
+	Type           string `json:"type"` // user, api_key, service or anonymous
+	OrganizationID string `json:"organization_id,omitempty"`
+	AuthMethod     string `json:"auth_method,omitempty"`
+	ClientIP       string `json:"client_ip,omitempty"`
+	UserAgent      string `json:"user_agent,omitempty"`
+}
+
+// Record is one audited decision. Alerts are published as security alerts,
+// everything else as audit log entries
+type Record struct {
+	Action    string
+	Actor     Actor
+	Resource  string
+	Decision  string
+	Reason    string
+	Alert     bool
+	RequestID string
+	Details   map[string]interface{}
+}
+
+// Publisher is the part of the event bus client the auditor needs
+type Publisher interface {
+	PublishEvent(ctx context.Context, event eventbus.Event) error
+}
+
+type queued struct {
+	ctx    context.Context
+	record Record
+	at     time.Time
+}
+
+// Auditor queues records and publishes them from a single worker
+type Auditor struct {
+	publisher Publisher
+	timeout   time.Duration
+	logger    *logger.Logger
+
+	mu     sync.RWMutex
+	queue  chan queued
+	closed bool
+	done   chan struct{}
+
+	dropped atomic.Uint64
+
+	// observe is told the result of every record, e.g. for metrics
+	observe func(result string)
+}
+
+// New starts an auditor publishing through publisher
+func New(publisher Publisher, cfg config.AuditConfig, logger *logger.Logger) *Auditor {
+	size := cfg.QueueSize
+	if size <= 0 {
+		size = 1024
+	}
+	timeout := cfg.PublishTimeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	a := &Auditor{
+		publisher: publisher,
+		timeout:   timeout,
+		logger:    logger,
+		queue:     make(chan queued, size),
+		done:      make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// SetObserver sets a function told whether each record was published,
+// failed to publish or was dropped
+func (a *Auditor) SetObserver(observer func(result string)) {
+	a.observe = observer
+}
+
+// Record queues r without blocking. The trace of ctx is kept but not its
+// cancellation, so records of finished requests are still published. A nil
+// auditor ignores records
+func (a *Auditor) Record(ctx context.Context, r Record) {
+	if a == nil {
+		return
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.closed {
+		return
+	}
+
+	select {
+	case a.queue <- queued{ctx: context.WithoutCancel(ctx), record: r, at: time.Now().UTC()}:
+	default:
+		// Log the first drop of every burst rather than each one
+		if dropped := a.dropped.Add(1); dropped%100 == 1 {
+			a.logger.Warn("Audit queue full, dropping audit events",
+				"action", r.Action,
+				"dropped_total", dropped,
+			)
+		}
+		a.report(ResultDropped)
+	}
+}
+
+// Close stops accepting records and publishes those still queued until ctx
+// is done
+func (a *Auditor) Close(ctx context.Context) error {
+	if a == nil {
+		return nil
+	}
+
+	a.mu.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.queue)
+	}
+	a.mu.Unlock()
+
+	select {
+	case <-a.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("audit queue not drained: %w", ctx.Err())
+	}
+}
+
+func (a *Auditor) run() {
+	defer close(a.done)
+	for item := range a.queue {
+		a.publish(item)
+	}
+}
+
+func (a *Auditor) publish(item queued) {
+	ctx, cancel := context.WithTimeout(item.ctx, a.timeout)
+	defer cancel()
+
+	err := a.publisher.PublishEvent(ctx, event(item.record, item.at))
+	if err != nil {
+		a.logger.Warn("Failed to publish audit event",
+			"action", item.record.Action,
+			"decision", item.record.Decision,
+			"actor", item.record.Actor.ID,
+			"error", err,
+		)
+		a.report(ResultFailed)
+		return
+	}
+	a.report(ResultPublished)
+}
+
+func (a *Auditor) report(result string) {
+	if a.observe != nil {
+		a.observe(result)
+	}
+}
+
+// event converts a record to the event published on the bus
+func event(r Record, at time.Time) eventbus.Event {
+	eventType := eventbus.EventAuditLogCreated
+	if r.Alert {
+		eventType = eventbus.EventSecurityAlert
+	}
+
+	data := map[string]interface{}{
+		"action":   r.Action,
+		"actor":    r.Actor,
+		"resource": r.Resource,
+		"decision": r.Decision,
+	}
+	if r.Reason != "" {
+		data["reason"] = r.Reason
+	}
+	if len(r.Details) > 0 {
+		data["details"] = r.Details
+	}
+
+	var metadata map[string]string
+	if r.RequestID != "" {
+		metadata = map[string]string{"request_id": r.RequestID}
+	}
+
+	return eventbus.Event{
+		Type:      eventType,
+		Source:    eventbus.SourceGateway,
+		Subject:   r.Actor.ID,
+		Timestamp: at,
+		Data:      data,
+		Metadata:  metadata,
+		Version:   "1.0",
+	}
+}
+
+// FromRequest starts a record for the request in c: the actor is taken from
+// what authentication has stored in the context so far and the resource is
+// the request method and path
+func FromRequest(c *gin.Context, action string) Record {
+	actor := Actor{
+		ID:             c.GetString("user_id"),
+		OrganizationID: c.GetString("organization_id"),
+		AuthMethod:     c.GetString("auth_method"),
+		ClientIP:       c.ClientIP(),
+		UserAgent:      c.Request.UserAgent(),
+	}
+	switch {
+	case actor.ID == "":
+		actor.Type = "anonymous"
+	case c.GetBool("is_internal"):
+		actor.Type = "service"
+	case actor.AuthMethod == "api_key":
+		actor.Type = "api_key"
+	default:
+		actor.Type = "user"
+	}
+
+	return Record{
+		Action:    action,
+		Actor:     actor,
+		Resource:  c.Request.Method + " " + c.Request.URL.Path,
+		RequestID: c.GetString("request_id"),
+	}
+}
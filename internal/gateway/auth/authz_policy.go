@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+)
+
+// FailurePolicy decides a permission check when the Authorization Service
+// cannot be reached or returns an unreadable answer
+type FailurePolicy string
+
+const (
+	// FailOpen allows the request
+	FailOpen FailurePolicy = "fail_open"
+	// FailClosed rejects the request as temporarily unavailable
+	FailClosed FailurePolicy = "fail_closed"
+	// FailDegraded allows the request only if it needs no more than the
+	// degraded access level, and grants no more than that level
+	FailDegraded FailurePolicy = "degraded"
+)
+
+// Fallback kinds counted by AuthorizationPolicy
+const (
+	FallbackStale    = "stale"
+	FallbackOpen     = "fail_open"
+	FallbackClosed   = "fail_closed"
+	FallbackDegraded = "degraded"
+	FallbackDenied   = "degraded_denied"
+)
+
+// accessLevels orders the levels used by the Authorization Service
+var accessLevels = map[string]int{
+	"read_only":  1,
+	"read_write": 2,
+	"admin":      3,
+	"owner":      4,
+}
+
+type routePolicy struct {
+	prefix   string
+	segments []pathSegment // the prefix as a rule template, followed by any segments
+	policy   FailurePolicy
+}
+
+// AuthorizationPolicy holds the failure policy per route and counts every
+// decision made without a fresh answer from the Authorization Service. A nil
+// policy fails open with no stale grace, as the gateway always did
+type AuthorizationPolicy struct {
+	defaultPolicy FailurePolicy
+	routes        []routePolicy // longest prefix first
+	staleGrace    time.Duration
+	degradedLevel string
+
+	mu        sync.Mutex
+	fallbacks map[string]int64
+	observe   func(kind string)
+}
+
+// NewAuthorizationPolicy validates the configured policies
+func NewAuthorizationPolicy(cfg config.AuthorizationConfig) (*AuthorizationPolicy, error) {
+	p := &AuthorizationPolicy{
+		staleGrace:    cfg.StaleGrace,
+		degradedLevel: cfg.DegradedLevel,
+		fallbacks:     make(map[string]int64),
+	}
+	if p.degradedLevel == "" {
+		p.degradedLevel = "read_only"
+	}
+	if _, known := accessLevels[p.degradedLevel]; !known {
+		return nil, fmt.Errorf("unknown degraded_level %q", cfg.DegradedLevel)
+	}
+
+	policy, err := parseFailurePolicy(cfg.DefaultPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("default_policy: %w", err)
+	}
+	p.defaultPolicy = policy
+
+	seen := make(map[string]bool, len(cfg.Policies))
+	for _, route := range cfg.Policies {
+		segments, err := parsePathTemplate(route.PathPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("authorization policy path_prefix: %w", err)
+		}
+		if seen[route.PathPrefix] {
+			return nil, fmt.Errorf("duplicate authorization policy for %s", route.PathPrefix)
+		}
+		seen[route.PathPrefix] = true
+
+		policy, err := parseFailurePolicy(route.Policy)
+		if err != nil {
+			return nil, fmt.Errorf("policy for %s: %w", route.PathPrefix, err)
+		}
+		segments = append(segments, pathSegment{param: "**", rest: true})
+		p.routes = append(p.routes, routePolicy{prefix: route.PathPrefix, segments: segments, policy: policy})
+	}
+	sort.SliceStable(p.routes, func(i, j int) bool {
+		return len(p.routes[i].prefix) > len(p.routes[j].prefix)
+	})
+	return p, nil
+}
+
+func parseFailurePolicy(value string) (FailurePolicy, error) {
+	switch policy := FailurePolicy(value); policy {
+	case "":
+		return FailOpen, nil
+	case FailOpen, FailClosed, FailDegraded:
+		return policy, nil
+	}
+	return "", fmt.Errorf("unknown failure policy %q", value)
+}
+
+// PolicyFor returns the failure policy of the longest matching route prefix.
+// Prefixes match whole segments of the path, split like it is for rule
+// matching, and may use rule template parameters such as {prefix...}
+func (p *AuthorizationPolicy) PolicyFor(path string) FailurePolicy {
+	if p == nil {
+		return FailOpen
+	}
+	parts := splitPath(path)
+	for _, route := range p.routes {
+		if matchSegments(route.segments, parts, make(map[string]string)) {
+			return route.policy
+		}
+	}
+	return p.defaultPolicy
+}
+
+// StaleGrace returns how long past its TTL a cached decision may be served
+func (p *AuthorizationPolicy) StaleGrace() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return p.staleGrace
+}
+
+// DegradedLevel returns the access level granted by the degraded policy
+func (p *AuthorizationPolicy) DegradedLevel() string {
+	if p == nil {
+		return "read_only"
+	}
+	return p.degradedLevel
+}
+
+// AllowsDegraded reports whether a request needing requiredLevel may pass
+// under the degraded policy. Unknown levels are never allowed
+func (p *AuthorizationPolicy) AllowsDegraded(requiredLevel string) bool {
+	required, known := accessLevels[requiredLevel]
+	return known && required <= accessLevels[p.DegradedLevel()]
+}
+
+// SetObserver sets a function told about every recorded fallback
+func (p *AuthorizationPolicy) SetObserver(observe func(kind string)) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.observe = observe
+	p.mu.Unlock()
+}
+
+// RecordFallback counts a decision made without the Authorization Service
+func (p *AuthorizationPolicy) RecordFallback(kind string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.fallbacks[kind]++
+	observe := p.observe
+	p.mu.Unlock()
+
+	if observe != nil {
+		observe(kind)
+	}
+}
+
+// Stats returns the fallback counters by kind
+func (p *AuthorizationPolicy) Stats() map[string]interface{} {
+	stats := map[string]interface{}{}
+	if p == nil {
+		return stats
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for kind, count := range p.fallbacks {
+		stats[kind] = count
+	}
+	stats["default_policy"] = string(p.defaultPolicy)
+	return stats
+}
@@ -0,0 +1,374 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+// Cache key namespaces. Credentials and user IDs only appear hashed
+const (
+	tokenKeyPrefix    = "token:"
+	apiKeyKeyPrefix   = "apikey:"
+	apiKeyIDKeyPrefix = "apikey-id:"
+	decisionKeyPrefix = "decision:"
+	revokedKeyPrefix  = "revoked:"
+)
+
+// storeWarnInterval limits how often backend failures are logged
+const storeWarnInterval = 30 * time.Second
+
+// cacheEntry is a cached verification or authorization result
+type cacheEntry struct {
+	Valid      bool            `json:"valid"`
+	Subject    string          `json:"sub,omitempty"` // user the result belongs to, for revocation
+	Data       json.RawMessage `json:"data,omitempty"`
+	CachedAt   time.Time       `json:"at"`
+	FreshUntil time.Time       `json:"fresh,omitempty"` // set when the entry is kept past its TTL as a stale fallback
+}
+
+// VerificationCache caches token and API key verifications and authorization
+// decisions for a short time. Entries can be invalidated early, e.g. when the
+// event bus reports a revoked key or changed permissions. Backend failures are
+// treated as cache misses so they never fail a request
+type VerificationCache struct {
+	store       cacheStore
+	backend     string
+	logger      *logger.Logger
+	tokenTTL    time.Duration
+	apiKeyTTL   time.Duration
+	decisionTTL time.Duration
+	negativeTTL time.Duration
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	invalidations atomic.Int64
+
+	warnMu    sync.Mutex
+	lastWarn  time.Time
+	lastError error
+}
+
+// NewVerificationCache creates a cache with the memory or Redis backend
+func NewVerificationCache(cfg config.AuthCacheConfig, redisCfg config.RedisConfig, logger *logger.Logger) (*VerificationCache, error) {
+	c := &VerificationCache{
+		backend:     cfg.Backend,
+		logger:      logger,
+		tokenTTL:    cfg.TokenTTL,
+		apiKeyTTL:   cfg.APIKeyTTL,
+		decisionTTL: cfg.DecisionTTL,
+		negativeTTL: cfg.NegativeTTL,
+	}
+
+	switch cfg.Backend {
+	case "", "memory":
+		c.backend = "memory"
+		c.store = newMemoryStore(cfg.MaxEntries)
+	case "redis":
+		c.store = newRedisStore(redisCfg, cfg.KeyPrefix)
+	default:
+		return nil, fmt.Errorf("unsupported auth cache backend %q", cfg.Backend)
+	}
+
+	logger.Info("Auth verification cache enabled",
+		"backend", c.backend,
+		"token_ttl", c.tokenTTL,
+		"api_key_ttl", c.apiKeyTTL,
+		"decision_ttl", c.decisionTTL,
+		"negative_ttl", c.negativeTTL,
+	)
+	return c, nil
+}
+
+// HashCredential returns the hash under which a token or API key is cached.
+// Services publishing revocation events can send this instead of the secret
+func HashCredential(credential string) string {
+	sum := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(sum[:])
+}
+
+// Token looks up a cached token verification. result receives the cached
+// response; found is false on a miss
+func (c *VerificationCache) Token(ctx context.Context, token string, result interface{}) (valid, found bool) {
+	entry := c.lookup(ctx, tokenKeyPrefix+HashCredential(token), result, false)
+	return entry != nil && entry.Valid, entry != nil
+}
+
+// StoreToken caches a token verification. Valid results live no longer than
+// the token itself; invalid ones use the negative TTL
+func (c *VerificationCache) StoreToken(ctx context.Context, token, subject string, valid bool, expiresAt time.Time, result interface{}) {
+	ttl := c.negativeTTL
+	if valid {
+		ttl = boundedTTL(c.tokenTTL, expiresAt)
+	}
+	c.save(ctx, tokenKeyPrefix+HashCredential(token), subject, valid, ttl, 0, result)
+}
+
+// APIKey looks up a cached API key verification
+func (c *VerificationCache) APIKey(ctx context.Context, apiKey string, result interface{}) (valid, found bool) {
+	entry := c.lookup(ctx, apiKeyKeyPrefix+HashCredential(apiKey), result, false)
+	return entry != nil && entry.Valid, entry != nil
+}
+
+// StoreAPIKey caches an API key verification. keyID lets a revocation that
+// only names the key find the cached entry
+func (c *VerificationCache) StoreAPIKey(ctx context.Context, apiKey, keyID, subject string, valid bool, result interface{}) {
+	hash := HashCredential(apiKey)
+	if !valid {
+		c.save(ctx, apiKeyKeyPrefix+hash, subject, false, c.negativeTTL, 0, result)
+		return
+	}
+
+	if c.save(ctx, apiKeyKeyPrefix+hash, subject, true, c.apiKeyTTL, 0, result) && keyID != "" {
+		if err := c.store.set(ctx, apiKeyIDKeyPrefix+keyID, []byte(hash), c.apiKeyTTL); err != nil {
+			c.storeFailed("set", err)
+		}
+	}
+}
+
+// Decision looks up a fresh cached authorization decision
+func (c *VerificationCache) Decision(ctx context.Context, userID, resourceType, resourceName, level string, result interface{}) bool {
+	return c.lookup(ctx, decisionKey(userID, resourceType, resourceName, level), result, false) != nil
+}
+
+// StaleDecision looks up a cached decision that may be past its TTL but is
+// still within its grace window. It returns the age of the decision
+func (c *VerificationCache) StaleDecision(ctx context.Context, userID, resourceType, resourceName, level string, result interface{}) (time.Duration, bool) {
+	entry := c.lookup(ctx, decisionKey(userID, resourceType, resourceName, level), result, true)
+	if entry == nil {
+		return 0, false
+	}
+	return time.Since(entry.CachedAt), true
+}
+
+// StoreDecision caches an authorization decision, allowed or denied. With a
+// grace period the decision is kept that much longer for StaleDecision, but
+// never past the decision's own expiry
+func (c *VerificationCache) StoreDecision(ctx context.Context, userID, resourceType, resourceName, level string, allowed bool, expiresAt time.Time, grace time.Duration, result interface{}) {
+	fresh := boundedTTL(c.decisionTTL, expiresAt)
+	if grace > 0 {
+		grace = boundedTTL(fresh+grace, expiresAt) - fresh
+	}
+	c.save(ctx, decisionKey(userID, resourceType, resourceName, level), userID, allowed, fresh, grace, result)
+}
+
+// InvalidateToken drops a cached token by its HashCredential hash
+func (c *VerificationCache) InvalidateToken(ctx context.Context, tokenHash string) {
+	c.invalidations.Add(1)
+	if err := c.store.delete(ctx, tokenKeyPrefix+tokenHash); err != nil {
+		c.storeFailed("delete", err)
+	}
+}
+
+// InvalidateAPIKey drops a cached API key by its key ID
+func (c *VerificationCache) InvalidateAPIKey(ctx context.Context, keyID string) {
+	c.invalidations.Add(1)
+	hash, found, err := c.store.get(ctx, apiKeyIDKeyPrefix+keyID)
+	if err != nil {
+		c.storeFailed("get", err)
+		return
+	}
+	keys := []string{apiKeyIDKeyPrefix + keyID}
+	if found {
+		keys = append(keys, apiKeyKeyPrefix+string(hash))
+	}
+	if err := c.store.delete(ctx, keys...); err != nil {
+		c.storeFailed("delete", err)
+	}
+}
+
+// InvalidateAPIKeyHash drops a cached API key by its HashCredential hash
+func (c *VerificationCache) InvalidateAPIKeyHash(ctx context.Context, keyHash string) {
+	c.invalidations.Add(1)
+	if err := c.store.delete(ctx, apiKeyKeyPrefix+keyHash); err != nil {
+		c.storeFailed("delete", err)
+	}
+}
+
+// InvalidateSubject drops everything cached for a user: their token and API
+// key verifications and their authorization decisions. Credentials are only
+// indexed by hash, so earlier results are rejected via a revocation marker
+func (c *VerificationCache) InvalidateSubject(ctx context.Context, subject string) {
+	marker, err := json.Marshal(time.Now())
+	if err == nil {
+		ttl := c.tokenTTL
+		if c.apiKeyTTL > ttl {
+			ttl = c.apiKeyTTL
+		}
+		if err := c.store.set(ctx, revokedKeyPrefix+HashCredential(subject), marker, ttl+time.Second); err != nil {
+			c.storeFailed("set", err)
+		}
+	}
+	c.InvalidateDecisions(ctx, subject)
+}
+
+// InvalidateDecisions drops the cached authorization decisions of a user, or
+// of all users when userID is empty
+func (c *VerificationCache) InvalidateDecisions(ctx context.Context, userID string) {
+	c.invalidations.Add(1)
+	prefix := decisionKeyPrefix
+	if userID != "" {
+		prefix += HashCredential(userID) + ":"
+	}
+	if _, err := c.store.deletePrefix(ctx, prefix); err != nil {
+		c.storeFailed("delete", err)
+	}
+}
+
+// Flush drops all cached results
+func (c *VerificationCache) Flush(ctx context.Context) {
+	c.invalidations.Add(1)
+	for _, prefix := range []string{tokenKeyPrefix, apiKeyKeyPrefix, apiKeyIDKeyPrefix, decisionKeyPrefix, revokedKeyPrefix} {
+		if _, err := c.store.deletePrefix(ctx, prefix); err != nil {
+			c.storeFailed("delete", err)
+			return
+		}
+	}
+}
+
+// Stats describes the cache for health reporting
+func (c *VerificationCache) Stats() map[string]interface{} {
+	stats := map[string]interface{}{
+		"backend":       c.backend,
+		"hits":          c.hits.Load(),
+		"misses":        c.misses.Load(),
+		"invalidations": c.invalidations.Load(),
+	}
+	if size := c.store.size(); size >= 0 {
+		stats["entries"] = size
+	}
+
+	c.warnMu.Lock()
+	defer c.warnMu.Unlock()
+	if c.lastError != nil {
+		stats["last_error"] = c.lastError.Error()
+	}
+	return stats
+}
+
+// Close releases the backend connection
+func (c *VerificationCache) Close() error {
+	return c.store.close()
+}
+
+// lookup reads an entry and rejects it if its subject was revoked after the
+// entry was cached. Entries past their TTL are only returned if allowStale
+func (c *VerificationCache) lookup(ctx context.Context, key string, result interface{}, allowStale bool) *cacheEntry {
+	data, found, err := c.store.get(ctx, key)
+	if err != nil {
+		c.storeFailed("get", err)
+	}
+	if !found {
+		c.misses.Add(1)
+		return nil
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		c.misses.Add(1)
+		return nil
+	}
+	if !allowStale && !entry.FreshUntil.IsZero() && time.Now().After(entry.FreshUntil) {
+		c.misses.Add(1)
+		return nil
+	}
+	if entry.Subject != "" && c.revokedSince(ctx, entry.Subject, entry.CachedAt) {
+		c.store.delete(ctx, key)
+		c.misses.Add(1)
+		return nil
+	}
+	if len(entry.Data) > 0 && result != nil {
+		if err := json.Unmarshal(entry.Data, result); err != nil {
+			c.misses.Add(1)
+			return nil
+		}
+	}
+
+	c.hits.Add(1)
+	return &entry
+}
+
+// save writes an entry that is fresh for ttl and kept for grace longer as a
+// stale fallback. It reports whether the entry was stored
+func (c *VerificationCache) save(ctx context.Context, key, subject string, valid bool, ttl, grace time.Duration, result interface{}) bool {
+	if ttl <= 0 {
+		return false
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return false
+	}
+	now := time.Now()
+	record := cacheEntry{Valid: valid, Subject: subject, Data: data, CachedAt: now}
+	if grace > 0 {
+		record.FreshUntil = now.Add(ttl)
+		ttl += grace
+	}
+	entry, err := json.Marshal(record)
+	if err != nil {
+		return false
+	}
+	if err := c.store.set(ctx, key, entry, ttl); err != nil {
+		c.storeFailed("set", err)
+		return false
+	}
+	return true
+}
+
+func (c *VerificationCache) revokedSince(ctx context.Context, subject string, cachedAt time.Time) bool {
+	data, found, err := c.store.get(ctx, revokedKeyPrefix+HashCredential(subject))
+	if err != nil {
+		c.storeFailed("get", err)
+		// Without the marker the entry cannot be trusted
+		return true
+	}
+	if !found {
+		return false
+	}
+	var revokedAt time.Time
+	if err := json.Unmarshal(data, &revokedAt); err != nil {
+		return true
+	}
+	return !cachedAt.After(revokedAt)
+}
+
+// storeFailed records a backend error, logging at most once per interval
+func (c *VerificationCache) storeFailed(operation string, err error) {
+	c.warnMu.Lock()
+	defer c.warnMu.Unlock()
+	c.lastError = err
+	if time.Since(c.lastWarn) < storeWarnInterval {
+		return
+	}
+	c.lastWarn = time.Now()
+	c.logger.Warn("Auth cache backend error, continuing without cache",
+		"backend", c.backend,
+		"operation", operation,
+		"error", err,
+	)
+}
+
+// decisionKey groups decisions by user so they can be invalidated together
+func decisionKey(userID, resourceType, resourceName, level string) string {
+	return decisionKeyPrefix + HashCredential(userID) + ":" +
+		HashCredential(resourceType+"\x00"+resourceName+"\x00"+level)
+}
+
+// boundedTTL caps ttl by the time left until expiresAt, if known
+func boundedTTL(ttl time.Duration, expiresAt time.Time) time.Duration {
+	if expiresAt.IsZero() {
+		return ttl
+	}
+	if remaining := time.Until(expiresAt); remaining < ttl {
+		return remaining
+	}
+	return ttl
+}
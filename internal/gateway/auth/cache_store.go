@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// cacheStore is the storage behind VerificationCache. Keys are already
+// hashed; values are opaque
+type cacheStore interface {
+	get(ctx context.Context, key string) ([]byte, bool, error)
+	set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	delete(ctx context.Context, keys ...string) error
+	deletePrefix(ctx context.Context, prefix string) (int, error)
+	size() int // number of entries, or -1 when unknown
+	close() error
+}
+
+// memoryStore is a size-bounded LRU with per-entry expiry
+type memoryStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List // front is the most recently used entry
+}
+
+type memoryItem struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func newMemoryStore(maxEntries int) *memoryStore {
+	if maxEntries <= 0 {
+		maxEntries = 10000
+	}
+	return &memoryStore{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+func (s *memoryStore) get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, exists := s.entries[key]
+	if !exists {
+		return nil, false, nil
+	}
+	item := element.Value.(*memoryItem)
+	if time.Now().After(item.expiresAt) {
+		s.remove(element)
+		return nil, false, nil
+	}
+	s.order.MoveToFront(element)
+	return item.value, true, nil
+}
+
+func (s *memoryStore) set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if element, exists := s.entries[key]; exists {
+		item := element.Value.(*memoryItem)
+		item.value, item.expiresAt = value, expiresAt
+		s.order.MoveToFront(element)
+		return nil
+	}
+
+	s.entries[key] = s.order.PushFront(&memoryItem{key: key, value: value, expiresAt: expiresAt})
+	for len(s.entries) > s.maxEntries {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+func (s *memoryStore) delete(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		if element, exists := s.entries[key]; exists {
+			s.remove(element)
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) deletePrefix(_ context.Context, prefix string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := 0
+	for key, element := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			s.remove(element)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+func (s *memoryStore) size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
+
+func (s *memoryStore) close() error {
+	return nil
+}
+
+// remove drops an entry. Callers hold s.mu
+func (s *memoryStore) remove(element *list.Element) {
+	s.order.Remove(element)
+	delete(s.entries, element.Value.(*memoryItem).key)
+}
+
+// redisStore shares cached results between gateway replicas
+type redisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+func newRedisStore(cfg config.RedisConfig, prefix string) *redisStore {
+	return &redisStore{
+		client: redis.NewClient(&redis.Options{
+			Addr:         fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+			Password:     cfg.Password,
+			DB:           cfg.Database,
+			DialTimeout:  2 * time.Second,
+			ReadTimeout:  500 * time.Millisecond,
+			WriteTimeout: 500 * time.Millisecond,
+		}),
+		prefix: prefix,
+	}
+}
+
+func (s *redisStore) get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (s *redisStore) set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, s.prefix+key, value, ttl).Err()
+}
+
+func (s *redisStore) delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = s.prefix + key
+	}
+	return s.client.Unlink(ctx, prefixed...).Err()
+}
+
+// deletePrefix scans for matching keys; it is only used for invalidations,
+// which are rare compared to lookups
+func (s *redisStore) deletePrefix(ctx context.Context, prefix string) (int, error) {
+	pattern := escapeGlob(s.prefix+prefix) + "*"
+	removed := 0
+	var cursor uint64
+	for {
+		keys, next, err := s.client.Scan(ctx, cursor, pattern, 500).Result()
+		if err != nil {
+			return removed, err
+		}
+		if len(keys) > 0 {
+			if err := s.client.Unlink(ctx, keys...).Err(); err != nil {
+				return removed, err
+			}
+			removed += len(keys)
+		}
+		cursor = next
+		if cursor == 0 {
+			return removed, nil
+		}
+	}
+}
+
+func (s *redisStore) size() int {
+	return -1
+}
+
+func (s *redisStore) close() error {
+	return s.client.Close()
+}
+
+// escapeGlob escapes Redis SCAN pattern metacharacters
+func escapeGlob(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
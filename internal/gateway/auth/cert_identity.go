@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+)
+
+// CertificateIdentities maps verified client certificates to internal services
+type CertificateIdentities struct {
+	services map[string]string // certificate subject -> service name
+}
+
+// NewCertificateIdentities builds the subject to service mapping
+func NewCertificateIdentities(identities []config.ClientIdentityConfig) (*CertificateIdentities, error) {
+	m := &CertificateIdentities{services: make(map[string]string, len(identities))}
+	for _, identity := range identities {
+		if identity.Subject == "" || identity.Service == "" {
+			return nil, fmt.Errorf("client identity needs both subject and service")
+		}
+		if existing, exists := m.services[identity.Subject]; exists && existing != identity.Service {
+			return nil, fmt.Errorf("client identity %s maps to both %s and %s", identity.Subject, existing, identity.Service)
+		}
+		m.services[identity.Subject] = identity.Service
+	}
+	return m, nil
+}
+
+// Resolve returns the service of the client certificate on a connection.
+// Only certificates verified against the client CA are considered; URI SANs
+// are checked first, then DNS SANs, then the common name
+func (m *CertificateIdentities) Resolve(state *tls.ConnectionState) (string, bool) {
+	if m == nil || state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	leaf := state.VerifiedChains[0][0]
+
+	for _, uri := range leaf.URIs {
+		if service, exists := m.services[uri.String()]; exists {
+			return service, true
+		}
+	}
+	for _, name := range leaf.DNSNames {
+		if service, exists := m.services[name]; exists {
+			return service, true
+		}
+	}
+	if service, exists := m.services[leaf.Subject.CommonName]; exists && leaf.Subject.CommonName != "" {
+		return service, true
+	}
+	return "", false
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/isa-cloud/isa_cloud/internal/eventbus"
+)
+
+// invalidationSources are the services whose events can make cached results stale
+var invalidationSources = []string{
+	eventbus.SourceAuthService,
+	eventbus.SourceAuthorizationService,
+	eventbus.SourceUserService,
+	eventbus.SourceOrgService,
+	eventbus.SourcePaymentService,
+}
+
+// invalidDurableChars are not allowed in JetStream consumer names
+var invalidDurableChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// WatchInvalidations subscribes to the event bus and invalidates cached
+// results until ctx is done. Every replica needs its own consumer, so the
+// consumer names include the host name
+func (c *VerificationCache) WatchInvalidations(ctx context.Context, bus *eventbus.EventBusClient, clientID string) {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "gateway"
+	}
+
+	for _, source := range invalidationSources {
+		durable := invalidDurableChars.ReplaceAllString(fmt.Sprintf("%s-auth-cache-%s-%s", clientID, host, source), "_")
+		go func(source, durable string) {
+			err := bus.SubscribeToEvents(ctx, source+".>", c.HandleEvent,
+				eventbus.WithDurable(durable),
+				eventbus.WithDeliverNew(),
+			)
+			if err != nil {
+				c.logger.Warn("Auth cache invalidation subscription failed; entries expire by TTL only",
+					"source", source,
+					"error", err,
+				)
+			}
+		}(source, durable)
+	}
+}
+
+// HandleEvent invalidates the cached results affected by an event. Unrelated
+// events are ignored
+func (c *VerificationCache) HandleEvent(ctx context.Context, event eventbus.Event) error {
+	userID := eventString(event, "user_id")
+
+	switch event.Type {
+	case eventbus.EventTokenRevoked:
+		if hash := credentialHash(event, "token_hash", "token"); hash != "" {
+			c.InvalidateToken(ctx, hash)
+		}
+		if userID != "" {
+			c.InvalidateSubject(ctx, userID)
+		}
+	case eventbus.EventAPIKeyRevoked:
+		if keyID := eventString(event, "key_id"); keyID != "" {
+			c.InvalidateAPIKey(ctx, keyID)
+			c.InvalidateSubject(ctx, "api-key-"+keyID)
+		}
+		if hash := credentialHash(event, "api_key_hash", "api_key"); hash != "" {
+			c.InvalidateAPIKeyHash(ctx, hash)
+		}
+	case eventbus.EventUserLoggedOut, eventbus.EventUserDeleted, eventbus.EventUserPasswordReset:
+		if userID != "" {
+			c.InvalidateSubject(ctx, userID)
+		}
+	case eventbus.EventPermissionsChanged, eventbus.EventOrgMemberAdded, eventbus.EventOrgMemberRemoved,
+		eventbus.EventSubscriptionCreated, eventbus.EventSubscriptionCanceled:
+		// Without a user the change may affect anyone, e.g. a role definition
+		c.InvalidateDecisions(ctx, userID)
+	default:
+		return nil
+	}
+
+	c.logger.Debug("Auth cache invalidated by event",
+		"type", event.Type,
+		"source", event.Source,
+		"event_id", event.ID,
+	)
+	return nil
+}
+
+func eventString(event eventbus.Event, key string) string {
+	value, _ := event.Data[key].(string)
+	return value
+}
+
+// credentialHash reads a credential hash from an event, hashing a raw
+// credential if that is what the publisher sent
+func credentialHash(event eventbus.Event, hashKey, rawKey string) string {
+	if hash := eventString(event, hashKey); hash != "" {
+		return hash
+	}
+	if raw := eventString(event, rawKey); raw != "" {
+		return HashCredential(raw)
+	}
+	return ""
+}
@@ -0,0 +1,285 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+// minJWKSRefreshInterval limits refreshes triggered by unknown key IDs
+const minJWKSRefreshInterval = 30 * time.Second
+
+// jsonWebKey is the subset of RFC 7517 fields needed for RSA and EC keys
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// keySet holds verification keys from static PEM files and a JWKS endpoint
+type keySet struct {
+	url    string
+	client *http.Client
+	logger *logger.Logger
+
+	mu          sync.RWMutex
+	static      map[string]crypto.PublicKey // kid -> key; "" holds keys without kid
+	anonymous   []crypto.PublicKey
+	remote      map[string]crypto.PublicKey
+	lastRefresh time.Time
+	lastError   error
+
+	refreshMu sync.Mutex
+}
+
+func newKeySet(url string, logger *logger.Logger) *keySet {
+	return &keySet{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: logger,
+		static: make(map[string]crypto.PublicKey),
+		remote: make(map[string]crypto.PublicKey),
+	}
+}
+
+// addPEM loads a static key from a PEM file
+func (s *keySet) addPEM(kid, file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read key file %s: %w", file, err)
+	}
+	key, err := parsePublicKeyPEM(data)
+	if err != nil {
+		return fmt.Errorf("invalid key file %s: %w", file, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if kid == "" {
+		s.anonymous = append(s.anonymous, key)
+	} else {
+		s.static[kid] = key
+	}
+	return nil
+}
+
+// candidates returns the keys that may have signed a token with the given kid
+func (s *keySet) candidates(kid string) []crypto.PublicKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if kid != "" {
+		if key, exists := s.static[kid]; exists {
+			return []crypto.PublicKey{key}
+		}
+		if key, exists := s.remote[kid]; exists {
+			return []crypto.PublicKey{key}
+		}
+	}
+
+	keys := append([]crypto.PublicKey{}, s.anonymous...)
+	if kid == "" {
+		for _, key := range s.remote {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// refresh fetches the JWKS endpoint. Unless forced, it is a no-op when the
+// keys were refreshed recently
+func (s *keySet) refresh(ctx context.Context, force bool) error {
+	if s.url == "" {
+		return nil
+	}
+
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	s.mu.RLock()
+	recent := time.Since(s.lastRefresh) < minJWKSRefreshInterval
+	s.mu.RUnlock()
+	if recent && !force {
+		return nil
+	}
+
+	keys, err := s.fetch(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastRefresh = time.Now()
+	s.lastError = err
+	if err != nil {
+		// Keep serving the last known keys
+		return err
+	}
+	s.remote = keys
+	return nil
+}
+
+func (s *keySet) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned status %d", resp.StatusCode)
+	}
+
+	var document struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(document.Keys))
+	for _, jwk := range document.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			s.logger.Warn("Skipping unusable JWKS key", "kid", jwk.KeyID, "error", err)
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+	return keys, nil
+}
+
+// run refreshes the key set periodically until ctx is done
+func (s *keySet) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.refresh(ctx, true); err != nil {
+				s.logger.Warn("JWKS refresh failed, keeping previous keys", "url", s.url, "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// status describes the key set for health reporting
+func (s *keySet) status() map[string]interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := map[string]interface{}{
+		"static_keys": len(s.static) + len(s.anonymous),
+		"jwks_keys":   len(s.remote),
+	}
+	if s.url != "" {
+		status["jwks_url"] = s.url
+		status["last_refresh"] = s.lastRefresh
+		if s.lastError != nil {
+			status["last_error"] = s.lastError.Error()
+		}
+	}
+	return status
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31 {
+			return nil, fmt.Errorf("unsupported exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %s", k.Curve)
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// parsePublicKeyPEM parses a PEM public key or certificate
+func parsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	}
+}
@@ -0,0 +1,264 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+var (
+	// ErrNotJWT is returned for tokens that are not JWTs (opaque tokens)
+	ErrNotJWT = errors.New("token is not a JWT")
+
+	// ErrUnknownIssuer is returned for JWTs from issuers the gateway does not
+	// verify locally
+	ErrUnknownIssuer = errors.New("token issuer is not trusted for local verification")
+
+	errNoMatchingKey = errors.New("no verification key matches the token")
+)
+
+// Claims are the identity claims of a verified token
+type Claims struct {
+	Subject        string
+	Email          string
+	Issuer         string
+	OrganizationID string
+	Scopes         []string
+	ExpiresAt      time.Time
+}
+
+// JWTVerifier verifies JWTs locally using static keys, a JWKS endpoint or a
+// shared HMAC secret
+type JWTVerifier struct {
+	logger    *logger.Logger
+	keys      *keySet
+	secret    []byte
+	issuers   map[string]bool
+	audience  []string
+	methods   []string
+	clockSkew time.Duration
+
+	stop context.CancelFunc
+}
+
+// NewJWTVerifier creates a verifier from the JWT configuration. The JWKS is
+// fetched in the background; an unreachable endpoint does not fail startup
+func NewJWTVerifier(cfg config.JWTConfig, logger *logger.Logger) (*JWTVerifier, error) {
+	v := &JWTVerifier{
+		logger:    logger,
+		keys:      newKeySet(cfg.JWKSURL, logger),
+		issuers:   make(map[string]bool),
+		audience:  cfg.Audience,
+		clockSkew: cfg.ClockSkew,
+	}
+
+	issuers := cfg.TrustedIssuers
+	if len(issuers) == 0 && cfg.Issuer != "" {
+		issuers = []string{cfg.Issuer}
+	}
+	if len(issuers) == 0 {
+		return nil, fmt.Errorf("local JWT verification requires at least one trusted issuer")
+	}
+	for _, issuer := range issuers {
+		v.issuers[issuer] = true
+	}
+
+	for _, method := range cfg.Algorithms {
+		method = strings.ToUpper(method)
+		switch method {
+		case "RS256", "ES256":
+		case "HS256":
+			if cfg.Secret == "" {
+				return nil, fmt.Errorf("HS256 requires security.jwt.secret")
+			}
+			v.secret = []byte(cfg.Secret)
+		default:
+			return nil, fmt.Errorf("unsupported JWT algorithm %q", method)
+		}
+		v.methods = append(v.methods, method)
+	}
+	if len(v.methods) == 0 {
+		return nil, fmt.Errorf("no JWT algorithms configured")
+	}
+
+	for _, key := range cfg.PublicKeys {
+		if err := v.keys.addPEM(key.KeyID, key.File); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v.stop = cancel
+	if cfg.JWKSURL != "" {
+		go func() {
+			if err := v.keys.refresh(ctx, true); err != nil {
+				logger.Warn("Initial JWKS fetch failed, will retry", "url", cfg.JWKSURL, "error", err)
+			}
+			refresh := cfg.JWKSRefresh
+			if refresh <= 0 {
+				refresh = 15 * time.Minute
+			}
+			v.keys.run(ctx, refresh)
+		}()
+	}
+
+	logger.Info("Local JWT verification enabled",
+		"issuers", issuers,
+		"algorithms", v.methods,
+		"jwks_url", cfg.JWKSURL,
+		"static_keys", len(cfg.PublicKeys),
+	)
+	return v, nil
+}
+
+// Verify checks the signature, exp, nbf, iss and aud of a token. ErrNotJWT
+// and ErrUnknownIssuer mean the token should be verified elsewhere
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	if strings.Count(token, ".") != 2 {
+		return nil, ErrNotJWT
+	}
+
+	// Read the issuer and key ID before trusting anything in the token
+	var unverified jwt.MapClaims
+	header, _, err := jwt.NewParser().ParseUnverified(token, &unverified)
+	if err != nil {
+		return nil, ErrNotJWT
+	}
+	issuer, _ := unverified.GetIssuer()
+	if !v.issuers[issuer] {
+		return nil, ErrUnknownIssuer
+	}
+	kid, _ := header.Header["kid"].(string)
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(v.methods),
+		jwt.WithIssuer(issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(v.clockSkew),
+	}
+	if len(v.audience) > 0 {
+		options = append(options, jwt.WithAudience(v.audience...))
+	}
+	parser := jwt.NewParser(options...)
+
+	claims, err := v.parse(parser, token, kid)
+	if errors.Is(err, errNoMatchingKey) && kid != "" {
+		// The issuer may have rotated keys since the last refresh
+		if refreshErr := v.keys.refresh(ctx, false); refreshErr != nil {
+			v.logger.Warn("JWKS refresh for unknown key failed", "kid", kid, "error", refreshErr)
+		}
+		claims, err = v.parse(parser, token, kid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return claimsFrom(claims), nil
+}
+
+// parse verifies the token against every candidate key for its kid
+func (v *JWTVerifier) parse(parser *jwt.Parser, token, kid string) (jwt.MapClaims, error) {
+	var lastErr error = errNoMatchingKey
+	for _, key := range v.keyCandidates(kid) {
+		claims := jwt.MapClaims{}
+		_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+			return keyForMethod(t.Method, key)
+		})
+		if err == nil {
+			return claims, nil
+		}
+		if !errors.Is(err, jwt.ErrTokenSignatureInvalid) && !errors.Is(err, jwt.ErrTokenUnverifiable) {
+			// Claims or format errors will not change with another key
+			return nil, err
+		}
+		if !errors.Is(lastErr, jwt.ErrTokenSignatureInvalid) {
+			lastErr = err
+		}
+	}
+	if errors.Is(lastErr, jwt.ErrTokenUnverifiable) {
+		return nil, errNoMatchingKey
+	}
+	return nil, lastErr
+}
+
+func (v *JWTVerifier) keyCandidates(kid string) []interface{} {
+	var keys []interface{}
+	if v.secret != nil {
+		keys = append(keys, v.secret)
+	}
+	for _, key := range v.keys.candidates(kid) {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// keyForMethod rejects keys whose type does not match the signing method, so
+// an HMAC token can never be checked against a public key or vice versa
+func keyForMethod(method jwt.SigningMethod, key interface{}) (interface{}, error) {
+	switch method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if secret, ok := key.([]byte); ok {
+			return secret, nil
+		}
+	case *jwt.SigningMethodRSA:
+		if rsaKey, ok := key.(*rsa.PublicKey); ok {
+			return rsaKey, nil
+		}
+	case *jwt.SigningMethodECDSA:
+		if ecKey, ok := key.(*ecdsa.PublicKey); ok {
+			return ecKey, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: key type %T does not match %s", jwt.ErrTokenUnverifiable, key, method.Alg())
+}
+
+// Status describes the verifier for health reporting
+func (v *JWTVerifier) Status() map[string]interface{} {
+	return v.keys.status()
+}
+
+// Close stops background key refreshes
+func (v *JWTVerifier) Close() {
+	if v.stop != nil {
+		v.stop()
+	}
+}
+
+// claimsFrom maps standard and commonly used custom claims
+func claimsFrom(mc jwt.MapClaims) *Claims {
+	claims := &Claims{}
+	claims.Subject, _ = mc.GetSubject()
+	claims.Issuer, _ = mc.GetIssuer()
+	if userID, ok := mc["user_id"].(string); ok && userID != "" {
+		claims.Subject = userID
+	}
+	claims.Email, _ = mc["email"].(string)
+	for _, name := range []string{"organization_id", "org_id"} {
+		if org, ok := mc[name].(string); ok && org != "" {
+			claims.OrganizationID = org
+			break
+		}
+	}
+	if exp, err := mc.GetExpirationTime(); err == nil && exp != nil {
+		claims.ExpiresAt = exp.Time
+	}
+
+	if scope, ok := mc["scope"].(string); ok {
+		claims.Scopes = strings.Fields(scope)
+	}
+	if scopes, ok := mc["scopes"].([]interface{}); ok {
+		for _, s := range scopes {
+			if value, ok := s.(string); ok {
+				claims.Scopes = append(claims.Scopes, value)
+			}
+		}
+	}
+	return claims
+}
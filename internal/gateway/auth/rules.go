@@ -0,0 +1,326 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+)
+
+// defaultRules are used when no rules file is configured. They make the
+// permission checks the gateway has always made, which looked for segments
+// such as /api/chat and /tools/call anywhere below the service prefix
+var defaultRules = []config.AuthorizationRuleConfig{
+	{Name: "blockchain_balance", Path: "/api/v1/blockchain/{prefix...}/balance/{rest...}", ResourceType: "api_endpoint", ResourceName: "blockchain_balance_check", RequiredLevel: "read_only"},
+	{Name: "blockchain_transaction", Path: "/api/v1/blockchain/{prefix...}/transaction/{rest...}", ResourceType: "api_endpoint", ResourceName: "blockchain_transaction", RequiredLevel: "read_only"},
+	{Name: "blockchain_status", Path: "/api/v1/blockchain/{prefix...}/status/{rest...}", ResourceType: "api_endpoint", ResourceName: "blockchain_status", RequiredLevel: "read_only"},
+	{Name: "blockchain_general", Path: "/api/v1/blockchain/{rest...}", ResourceType: "api_endpoint", ResourceName: "blockchain_blockchain_general", RequiredLevel: "read_only"},
+	{Name: "agent_chat", Path: "/api/v1/agents/{prefix...}/api/chat/{rest...}", ResourceType: "api_endpoint", ResourceName: "agent_chat", RequiredLevel: "read_write"},
+	{Name: "agent_general", Path: "/api/v1/agents/{rest...}", ResourceType: "api_endpoint", ResourceName: "agent_chat", RequiredLevel: "read_only"},
+	{Name: "mcp_tool_call", Path: "/api/v1/mcp/{prefix...}/tools/call/{rest...}", ResourceType: "mcp_tool", ResourceName: "tool_execution", RequiredLevel: "read_write"},
+	{Name: "mcp_search", Path: "/api/v1/mcp/{prefix...}/search/{rest...}", ResourceType: "mcp_tool", ResourceName: "search", RequiredLevel: "read_only"},
+	{Name: "mcp_prompt", Path: "/api/v1/mcp/{prefix...}/prompts/get/{rest...}", ResourceType: "mcp_tool", ResourceName: "prompt_access", RequiredLevel: "read_only"},
+	{Name: "mcp_general", Path: "/api/v1/mcp/{rest...}", ResourceType: "mcp_tool", ResourceName: "mcp_general", RequiredLevel: "read_only"},
+	{Name: "gateway_management", Path: "/api/v1/gateway/{rest...}", ResourceType: "api_endpoint", ResourceName: "gateway_management", RequiredLevel: "read_only"},
+}
+
+var knownMethods = map[string]bool{
+	http.MethodGet: true, http.MethodHead: true, http.MethodPost: true, http.MethodPut: true,
+	http.MethodPatch: true, http.MethodDelete: true, http.MethodOptions: true,
+	http.MethodConnect: true, http.MethodTrace: true,
+}
+
+// pathSegment is one segment of a rule's path template
+type pathSegment struct {
+	literal string
+	param   string // set for {param} and {param...}
+	rest    bool   // {param...} matches any number of segments, possibly none
+}
+
+type compiledRule struct {
+	config   config.AuthorizationRuleConfig
+	segments []pathSegment
+	methods  map[string]bool
+}
+
+// RuleMatch is the permission check selected for a request
+type RuleMatch struct {
+	Rule          string            `json:"rule"`
+	ResourceType  string            `json:"resource_type"`
+	ResourceName  string            `json:"resource_name"`
+	RequiredLevel string            `json:"required_level"`
+	Params        map[string]string `json:"params,omitempty"`
+}
+
+// RuleEvaluation records why a rule did or did not match, for dry runs
+type RuleEvaluation struct {
+	Rule   string `json:"rule"`
+	Path   string `json:"path"`
+	Result string `json:"result"` // matched, path_mismatch or method_mismatch
+}
+
+// AuthorizationRules maps requests to permission checks
+type AuthorizationRules struct {
+	rules []compiledRule
+}
+
+// DefaultAuthorizationRules returns the built-in rules
+func DefaultAuthorizationRules() *AuthorizationRules {
+	rules, err := NewAuthorizationRules(defaultRules)
+	if err != nil {
+		panic(fmt.Sprintf("invalid built-in authorization rules: %v", err))
+	}
+	return rules
+}
+
+// LoadAuthorizationRules returns the rules from file, or the built-in rules
+// when file is empty
+func LoadAuthorizationRules(file string) (*AuthorizationRules, error) {
+	if file == "" {
+		return DefaultAuthorizationRules(), nil
+	}
+	rules, err := config.LoadAuthorizationRules(file)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("authorization rules file %s defines no rules", file)
+	}
+	return NewAuthorizationRules(rules)
+}
+
+// NewAuthorizationRules validates and compiles rules
+func NewAuthorizationRules(rules []config.AuthorizationRuleConfig) (*AuthorizationRules, error) {
+	compiled := &AuthorizationRules{rules: make([]compiledRule, 0, len(rules))}
+	names := make(map[string]bool, len(rules))
+
+	for i, rule := range rules {
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule_%d", i+1)
+		}
+		if names[rule.Name] {
+			return nil, fmt.Errorf("duplicate authorization rule name %s", rule.Name)
+		}
+		names[rule.Name] = true
+
+		segments, err := parsePathTemplate(rule.Path)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if rule.ResourceType == "" {
+			return nil, fmt.Errorf("rule %s: resource_type is required", rule.Name)
+		}
+		if rule.ResourceName == "" {
+			return nil, fmt.Errorf("rule %s: resource_name is required", rule.Name)
+		}
+		if _, known := accessLevels[rule.RequiredLevel]; !known {
+			return nil, fmt.Errorf("rule %s: unknown required_level %q", rule.Name, rule.RequiredLevel)
+		}
+		if err := checkNameTemplate(rule.ResourceName, segments); err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+
+		var methods map[string]bool
+		if len(rule.Methods) > 0 {
+			methods = make(map[string]bool, len(rule.Methods))
+			for _, method := range rule.Methods {
+				method = strings.ToUpper(method)
+				if !knownMethods[method] {
+					return nil, fmt.Errorf("rule %s: unknown method %q", rule.Name, method)
+				}
+				methods[method] = true
+			}
+		}
+
+		compiled.rules = append(compiled.rules, compiledRule{config: rule, segments: segments, methods: methods})
+	}
+	return compiled, nil
+}
+
+// parsePathTemplate splits a template such as /api/v1/mcp/{tool}/{rest...}
+func parsePathTemplate(template string) ([]pathSegment, error) {
+	if !strings.HasPrefix(template, "/") {
+		return nil, fmt.Errorf("path %q must start with /", template)
+	}
+	if strings.Contains(template, "//") {
+		return nil, fmt.Errorf("path %q contains an empty segment", template)
+	}
+
+	parts := splitPath(template)
+	segments := make([]pathSegment, 0, len(parts))
+	params := make(map[string]bool)
+	for _, part := range parts {
+		if !strings.ContainsAny(part, "{}") {
+			segments = append(segments, pathSegment{literal: part})
+			continue
+		}
+		if !strings.HasPrefix(part, "{") || !strings.HasSuffix(part, "}") {
+			return nil, fmt.Errorf("path %q: a parameter must fill its whole segment", template)
+		}
+
+		segment := pathSegment{param: part[1 : len(part)-1]}
+		if name, rest := strings.CutSuffix(segment.param, "..."); rest {
+			segment.param, segment.rest = name, true
+		}
+		if segment.param == "" || strings.ContainsAny(segment.param, "{}./") {
+			return nil, fmt.Errorf("path %q: invalid parameter name %q", template, segment.param)
+		}
+		if params[segment.param] {
+			return nil, fmt.Errorf("path %q: duplicate parameter %s", template, segment.param)
+		}
+		params[segment.param] = true
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+// checkNameTemplate makes sure resource_name only references path parameters
+func checkNameTemplate(name string, segments []pathSegment) error {
+	params := make(map[string]bool, len(segments))
+	for _, segment := range segments {
+		if segment.param != "" {
+			params[segment.param] = true
+		}
+	}
+
+	for rest := name; ; {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			if strings.Contains(rest, "}") {
+				return fmt.Errorf("resource_name %q has an unmatched }", name)
+			}
+			return nil
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return fmt.Errorf("resource_name %q has an unmatched {", name)
+		}
+		param := rest[start+1 : start+end]
+		if !params[param] {
+			return fmt.Errorf("resource_name %q references unknown parameter %s", name, param)
+		}
+		rest = rest[start+end+1:]
+	}
+}
+
+// CleanPath normalizes a request path once for route, rule and policy
+// matching. Dot segments are resolved and a trailing slash is kept. Paths
+// with empty segments are rejected: upstreams may resolve /a//b like /a/b
+// while prefix and segment matching would not
+func CleanPath(p string) (string, error) {
+	if !strings.HasPrefix(p, "/") {
+		return "", fmt.Errorf("path %q must start with /", p)
+	}
+	if strings.Contains(p, "//") {
+		return "", fmt.Errorf("path %q contains an empty segment", p)
+	}
+	cleaned := path.Clean(p)
+	if strings.HasSuffix(p, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+	return cleaned, nil
+}
+
+// splitPath returns the segments of a path, skipping empty ones
+func splitPath(path string) []string {
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+}
+
+// match returns the path parameters if the rule's template matches
+func (r *compiledRule) match(parts []string) (map[string]string, bool) {
+	params := make(map[string]string)
+	if !matchSegments(r.segments, parts, params) {
+		return nil, false
+	}
+	return params, true
+}
+
+// matchSegments matches parts against segments. A {param...} segment takes
+// the fewest parts that let the segments after it match
+func matchSegments(segments []pathSegment, parts []string, params map[string]string) bool {
+	for i, segment := range segments {
+		if segment.rest {
+			for n := 0; i+n <= len(parts); n++ {
+				if matchSegments(segments[i+1:], parts[i+n:], params) {
+					params[segment.param] = strings.Join(parts[i:i+n], "/")
+					return true
+				}
+			}
+			return false
+		}
+		if i >= len(parts) {
+			return false
+		}
+		if segment.param != "" {
+			params[segment.param] = parts[i]
+		} else if segment.literal != parts[i] {
+			return false
+		}
+	}
+	return len(parts) == len(segments)
+}
+
+func (r *compiledRule) result(params map[string]string) *RuleMatch {
+	name := r.config.ResourceName
+	for param, value := range params {
+		name = strings.ReplaceAll(name, "{"+param+"}", value)
+	}
+	return &RuleMatch{
+		Rule:          r.config.Name,
+		ResourceType:  r.config.ResourceType,
+		ResourceName:  name,
+		RequiredLevel: r.config.RequiredLevel,
+		Params:        params,
+	}
+}
+
+// Match returns the permission check of the first rule matching the request,
+// or false if the request needs no permission check
+func (a *AuthorizationRules) Match(method, path string) (*RuleMatch, bool) {
+	match, _ := a.evaluate(method, path, false)
+	return match, match != nil
+}
+
+// Explain evaluates the rules like Match and reports the outcome of every rule
+// up to the one that matched
+func (a *AuthorizationRules) Explain(method, path string) (*RuleMatch, []RuleEvaluation) {
+	return a.evaluate(method, path, true)
+}
+
+func (a *AuthorizationRules) evaluate(method, path string, explain bool) (*RuleMatch, []RuleEvaluation) {
+	if a == nil {
+		return nil, nil
+	}
+	parts := splitPath(path)
+	method = strings.ToUpper(method)
+
+	var evaluations []RuleEvaluation
+	for i := range a.rules {
+		rule := &a.rules[i]
+		params, matched := rule.match(parts)
+		result := "matched"
+		switch {
+		case !matched:
+			result = "path_mismatch"
+		case rule.methods != nil && !rule.methods[method]:
+			result = "method_mismatch"
+		}
+		if explain {
+			evaluations = append(evaluations, RuleEvaluation{Rule: rule.config.Name, Path: rule.config.Path, Result: result})
+		}
+		if result == "matched" {
+			return rule.result(params), evaluations
+		}
+	}
+	return nil, evaluations
+}
+
+// Len returns the number of rules
+func (a *AuthorizationRules) Len() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.rules)
+}
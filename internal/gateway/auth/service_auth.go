@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+	"github.com/isa-cloud/isa_cloud/pkg/serviceauth"
+)
+
+var (
+	// ErrNoServiceCredentials is returned for requests without a service signature
+	ErrNoServiceCredentials = errors.New("request carries no service credentials")
+
+	// ErrInvalidServiceCredentials is returned for signatures that do not verify
+	ErrInvalidServiceCredentials = errors.New("invalid service credentials")
+)
+
+// ServiceIdentity is an authenticated internal service
+type ServiceIdentity struct {
+	Name  string
+	KeyID string
+}
+
+type serviceKey struct {
+	id     string
+	secret []byte
+}
+
+// ServiceAuthenticator verifies HMAC-signed requests from internal services
+type ServiceAuthenticator struct {
+	logger      *logger.Logger
+	services    map[string][]serviceKey
+	skew        time.Duration
+	maxBodySize int64
+	nonces      *nonceCache
+	registered  bool
+}
+
+// NewServiceAuthenticator loads the per-service keys. Secret files are read once
+func NewServiceAuthenticator(cfg config.ServiceAuthConfig, logger *logger.Logger) (*ServiceAuthenticator, error) {
+	a := &ServiceAuthenticator{
+		logger:      logger,
+		services:    make(map[string][]serviceKey),
+		skew:        cfg.MaxClockSkew,
+		maxBodySize: cfg.MaxBodySize,
+		registered:  cfg.RequireRegistered,
+	}
+	if a.skew <= 0 {
+		a.skew = 5 * time.Minute
+	}
+	if a.maxBodySize <= 0 {
+		a.maxBodySize = 10 << 20
+	}
+	a.nonces = newNonceCache(2 * a.skew)
+
+	for _, service := range cfg.Services {
+		if service.Name == "" {
+			return nil, fmt.Errorf("service credential without a name")
+		}
+		if _, exists := a.services[service.Name]; exists {
+			return nil, fmt.Errorf("duplicate credentials for service %s", service.Name)
+		}
+		for i, key := range service.Keys {
+			secret := key.Secret
+			if key.SecretFile != "" {
+				data, err := os.ReadFile(key.SecretFile)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read secret for service %s: %w", service.Name, err)
+				}
+				secret = strings.TrimSpace(string(data))
+			}
+			if len(secret) < 32 {
+				return nil, fmt.Errorf("secret %d of service %s must be at least 32 bytes", i, service.Name)
+			}
+			a.services[service.Name] = append(a.services[service.Name], serviceKey{id: key.ID, secret: []byte(secret)})
+		}
+		if len(a.services[service.Name]) == 0 {
+			return nil, fmt.Errorf("service %s has no keys", service.Name)
+		}
+	}
+
+	logger.Info("Service authentication configured", "services", len(a.services), "max_clock_skew", a.skew)
+	return a, nil
+}
+
+// RequireRegistered reports whether authenticated services must also be
+// registered in the service catalog
+func (a *ServiceAuthenticator) RequireRegistered() bool {
+	return a.registered
+}
+
+// Authenticate verifies the service signature of a request. The body is read
+// for hashing and restored for the handlers that follow
+func (a *ServiceAuthenticator) Authenticate(r *http.Request) (*ServiceIdentity, error) {
+	name := r.Header.Get(serviceauth.HeaderServiceName)
+	signature := r.Header.Get(serviceauth.HeaderSignature)
+	if name == "" && signature == "" {
+		return nil, ErrNoServiceCredentials
+	}
+	if name == "" || signature == "" {
+		return nil, fmt.Errorf("%w: incomplete credentials", ErrInvalidServiceCredentials)
+	}
+
+	keys, exists := a.services[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: unknown service %q", ErrInvalidServiceCredentials, name)
+	}
+
+	timestamp, err := strconv.ParseInt(r.Header.Get(serviceauth.HeaderTimestamp), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timestamp", ErrInvalidServiceCredentials)
+	}
+	if drift := time.Since(time.Unix(timestamp, 0)); drift > a.skew || drift < -a.skew {
+		return nil, fmt.Errorf("%w: timestamp outside the accepted window", ErrInvalidServiceCredentials)
+	}
+
+	nonce := r.Header.Get(serviceauth.HeaderNonce)
+	if len(nonce) < 16 || len(nonce) > 128 {
+		return nil, fmt.Errorf("%w: invalid nonce", ErrInvalidServiceCredentials)
+	}
+
+	body, err := a.readBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical := serviceauth.CanonicalRequest(r.Method, r.URL.EscapedPath(), r.URL.RawQuery,
+		timestamp, nonce, serviceauth.BodyHash(body), name)
+
+	keyID := r.Header.Get(serviceauth.HeaderKeyID)
+	matched, found := "", false
+	for _, key := range keys {
+		if keyID != "" && key.id != keyID {
+			continue
+		}
+		if hmac.Equal([]byte(signature), []byte(serviceauth.Signature(key.secret, canonical))) {
+			matched, found = key.id, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: signature mismatch for service %q", ErrInvalidServiceCredentials, name)
+	}
+
+	// Only signed requests reach the nonce cache, so it cannot be flooded
+	if !a.nonces.add(name+"\x00"+nonce, time.Now()) {
+		return nil, fmt.Errorf("%w: replayed nonce", ErrInvalidServiceCredentials)
+	}
+
+	return &ServiceIdentity{Name: name, KeyID: matched}, nil
+}
+
+func (a *ServiceAuthenticator) readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, a.maxBodySize+1))
+	r.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	if int64(len(body)) > a.maxBodySize {
+		return nil, fmt.Errorf("%w: body exceeds %d bytes", ErrInvalidServiceCredentials, a.maxBodySize)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// nonceCache remembers nonces for as long as their timestamps are acceptable
+type nonceCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	seen      map[string]time.Time
+	lastSweep time.Time
+}
+
+func newNonceCache(ttl time.Duration) *nonceCache {
+	return &nonceCache{ttl: ttl, seen: make(map[string]time.Time)}
+}
+
+// add records a nonce and reports false if it was already used
+func (n *nonceCache) add(nonce string, now time.Time) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if now.Sub(n.lastSweep) > n.ttl/4 {
+		for key, seenAt := range n.seen {
+			if now.Sub(seenAt) > n.ttl {
+				delete(n.seen, key)
+			}
+		}
+		n.lastSweep = now
+	}
+
+	if seenAt, exists := n.seen[nonce]; exists && now.Sub(seenAt) <= n.ttl {
+		return false
+	}
+	n.seen[nonce] = now
+	return true
+}
@@ -2,22 +2,35 @@ package gateway
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 
 	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/internal/eventbus"
 	"github.com/isa-cloud/isa_cloud/pkg/logger"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/audit"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/auth"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/middleware"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/clients"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/metrics"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/grpcproxy"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/proxy"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/ratelimit"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/registry"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/blockchain"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/mqtt"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tlsutil"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
 )
 
 // Gateway represents the main gateway service
@@ -29,6 +42,22 @@ type Gateway struct {
 	registry          *registry.ConsulRegistry
 	blockchainGateway *blockchain.Gateway
 	mqttAdapter       *mqtt.Adapter
+	jwtVerifier       *auth.JWTVerifier
+	authCache         *auth.VerificationCache
+	authzPolicy       *auth.AuthorizationPolicy
+	authzRules        *auth.AuthorizationRules
+	rateLimits        *ratelimit.Policy
+	rateLimiter       ratelimit.Limiter
+	eventBus          *eventbus.EventBusClient
+	stopWatchers      context.CancelFunc
+	serverCerts       *tlsutil.Reloader
+	certIdentities    *auth.CertificateIdentities
+	authOptions       []middleware.AuthOption
+	metrics           *metrics.Metrics
+	shutdownTracing   func(context.Context) error
+	accessLog         *middleware.AccessLogger
+	auditor           *audit.Auditor
+	grpcForwarder     *grpcproxy.Forwarder
 }
 
 // New creates a new Gateway instance
@@ -49,6 +78,14 @@ func New(cfg *config.Config, logger *logger.Logger) (*Gateway, error) {
 	} else {
 		logger.Info("Connected to Consul service registry", "address", consulAddress)
 		
+		lb := cfg.Discovery.LoadBalancing
+		if err := consulRegistry.ConfigureLoadBalancing(lb.Strategy, lb.Services, lb.WeightMetaKey); err != nil {
+			return nil, fmt.Errorf("invalid load balancing configuration: %w", err)
+		}
+		
+		catalog := cfg.Discovery.Catalog
+		consulRegistry.ConfigureCatalog(catalog.WaitTime, catalog.RetryInterval, catalog.InitialFetchTimeout, catalog.IdleTimeout)
+		
 		// Register gateway itself with Consul
 		err = consulRegistry.RegisterService("gateway", "localhost", cfg.Server.HTTPPort, []string{"api", "gateway"})
 		if err != nil {
@@ -56,8 +93,216 @@ func New(cfg *config.Config, logger *logger.Logger) (*Gateway, error) {
 		}
 	}
 
+	// Initialize metrics; they are exported on their own listener when monitoring is enabled
+	gatewayMetrics := metrics.New(cfg.Monitoring.Namespace, cfg.Monitoring.Subsystem)
+
+	// Initialize tracing; trace context is propagated even when export is disabled
+	tracingCfg := cfg.Monitoring.Tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingCfg, cfg.App.Version, cfg.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tracing configuration: %w", err)
+	}
+	if tracingCfg.Enabled {
+		logger.Info("Tracing enabled", "exporter", tracingCfg.Exporter, "endpoint", tracingCfg.Endpoint, "sample_ratio", tracingCfg.SampleRatio)
+	}
+
+	// Initialize the access log; without it requests are logged to the application log
+	var accessLog *middleware.AccessLogger
+	if cfg.Logging.Access.Enabled {
+		accessLog, err = middleware.NewAccessLogger(cfg.Logging.Access)
+		if err != nil {
+			return nil, fmt.Errorf("invalid access log configuration: %w", err)
+		}
+	}
+
 	// Initialize dynamic proxy
-	dynamicProxy := proxy.NewDynamicProxy(cfg, logger, consulRegistry)
+	dynamicProxy, err := proxy.NewDynamicProxy(cfg, logger, consulRegistry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize dynamic proxy: %w", err)
+	}
+	dynamicProxy.SetObserver(proxy.Observer{
+		RequestStarted: gatewayMetrics.RequestStarted,
+		UpstreamError:  gatewayMetrics.UpstreamError,
+		StreamFinished: func(service string, stats proxy.StreamStats) {
+			gatewayMetrics.StreamFinished(service, stats.Duration, stats.Events, stats.Bytes, stats.Completed, stats.Disconnected)
+		},
+	})
+
+	// Initialize forwarding of the api/proto gRPC services; backends are the
+	// static grpc_port addresses, reached with the same TLS settings as the
+	// HTTP proxy
+	grpcForwarder, err := grpcproxy.New(cfg, dynamicProxy.ClientTLS(), logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize gRPC forwarding: %w", err)
+	}
+	grpcForwarder.SetObserver(grpcproxy.Observer{
+		RequestStarted: gatewayMetrics.RequestStarted,
+		UpstreamError:  gatewayMetrics.UpstreamError,
+	})
+
+	// Initialize local JWT verification
+	var jwtVerifier *auth.JWTVerifier
+	var authOptions []middleware.AuthOption
+	if cfg.Security.JWT.LocalVerification {
+		jwtVerifier, err = auth.NewJWTVerifier(cfg.Security.JWT, logger)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT verification configuration: %w", err)
+		}
+		authOptions = append(authOptions, middleware.WithJWTVerifier(jwtVerifier))
+	}
+
+	// Initialize HMAC authentication for internal services
+	if len(cfg.Security.ServiceAuth.Services) > 0 {
+		serviceAuth, err := auth.NewServiceAuthenticator(cfg.Security.ServiceAuth, logger)
+		if err != nil {
+			return nil, fmt.Errorf("invalid service authentication configuration: %w", err)
+		}
+		authOptions = append(authOptions, middleware.WithServiceAuthenticator(serviceAuth))
+	} else {
+		logger.Info("No internal service credentials configured; service-to-service authentication is disabled")
+	}
+
+	// Authorization failure policy
+	authzPolicy, err := auth.NewAuthorizationPolicy(cfg.Security.Authorization)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authorization policy configuration: %w", err)
+	}
+	authzPolicy.SetObserver(gatewayMetrics.AuthorizationFallback)
+	authOptions = append(authOptions, middleware.WithAuthorizationPolicy(authzPolicy))
+
+	// Authorization rules mapping requests to permission checks
+	authzRules, err := auth.LoadAuthorizationRules(cfg.Security.Authorization.RulesFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authorization rules: %w", err)
+	}
+	authOptions = append(authOptions, middleware.WithAuthorizationRules(authzRules))
+	logger.Info("Authorization rules loaded", "rules", authzRules.Len(), "file", cfg.Security.Authorization.RulesFile)
+
+	// Per-caller rate limits
+	var rateLimits *ratelimit.Policy
+	var rateLimiter ratelimit.Limiter
+	if cfg.Security.RateLimit.Enabled {
+		rateLimits, err = ratelimit.NewPolicy(cfg.Security.RateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit configuration: %w", err)
+		}
+		local := ratelimit.NewLocalLimiter(cfg.Security.RateLimit.IdleTTL)
+		switch cfg.Security.RateLimit.Backend {
+		case "", "memory":
+			rateLimiter = local
+		case "redis":
+			shared := ratelimit.NewRedisLimiter(cfg.Redis, cfg.Security.RateLimit.KeyPrefix)
+			rateLimiter = ratelimit.NewFallbackLimiter(shared, local, 0, logger)
+			logger.Info("Rate limits shared through Redis", "host", cfg.Redis.Host, "port", cfg.Redis.Port)
+		default:
+			return nil, fmt.Errorf("unsupported rate limit backend %q", cfg.Security.RateLimit.Backend)
+		}
+	}
+
+	// Authentication profile
+	if err := validateTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		return nil, err
+	}
+	switch cfg.Security.AuthProfile.Environment {
+	case "", "production":
+	case "development":
+		if cfg.Environment == "production" {
+			return nil, fmt.Errorf("the development auth profile cannot be used when environment is production")
+		}
+		authOptions = append(authOptions, middleware.WithDevelopmentBypass(cfg.Security.AuthProfile.DevUserAgents))
+		logger.Warn("!!! DEVELOPMENT AUTH BYPASS ENABLED !!! Loopback requests with a matching User-Agent are treated as internal services without credentials. Never run this profile where the gateway is reachable from other hosts",
+			"user_agents", cfg.Security.AuthProfile.DevUserAgents,
+			"trusted_proxies", cfg.Server.TrustedProxies,
+		)
+		if len(cfg.Server.TrustedProxies) == 0 {
+			logger.Warn("!!! No trusted_proxies configured: behind a local reverse proxy every request looks like loopback and gets the development bypass")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported auth profile environment %q", cfg.Security.AuthProfile.Environment)
+	}
+
+	// Initialize the event bus (optional - used for cache invalidation)
+	var eventBus *eventbus.EventBusClient
+	if cfg.EventBus.Enabled {
+		eventBus, err = eventbus.NewEventBusClient(&eventbus.Config{
+			NATSUrl:  cfg.EventBus.URL,
+			Username: cfg.EventBus.Username,
+			Password: cfg.EventBus.Password,
+			ClientID: cfg.EventBus.ClientID,
+		})
+		if err != nil {
+			logger.Warn("Failed to connect to event bus", "url", cfg.EventBus.URL, "error", err)
+			eventBus = nil
+		} else {
+			logger.Info("Connected to event bus", "url", cfg.EventBus.URL)
+			eventBus.SetPublishObserver(gatewayMetrics.EventPublished)
+		}
+	}
+
+	// Initialize security auditing; events are published to the event bus
+	var auditor *audit.Auditor
+	if cfg.Security.Audit.Enabled {
+		if eventBus != nil {
+			auditor = audit.New(eventBus, cfg.Security.Audit, logger)
+			auditor.SetObserver(gatewayMetrics.AuditEvent)
+			authOptions = append(authOptions, middleware.WithAuditor(auditor, cfg.Security.Audit.InternalServices))
+		} else {
+			logger.Warn("Security audit events are disabled: the event bus is not available")
+		}
+	}
+
+	// Initialize the auth verification cache
+	var authCache *auth.VerificationCache
+	watchCtx, stopWatchers := context.WithCancel(context.Background())
+	if cfg.Security.AuthCache.Enabled {
+		authCache, err = auth.NewVerificationCache(cfg.Security.AuthCache, cfg.Redis, logger)
+		if err != nil {
+			stopWatchers()
+			return nil, fmt.Errorf("invalid auth cache configuration: %w", err)
+		}
+		authOptions = append(authOptions, middleware.WithVerificationCache(authCache))
+		if eventBus != nil {
+			authCache.WatchInvalidations(watchCtx, eventBus, cfg.EventBus.ClientID)
+		} else {
+			logger.Info("Auth cache entries expire by TTL only; enable event_bus for early invalidation")
+		}
+	}
+
+	// Initialize TLS for the HTTP and gRPC listeners
+	var serverCerts *tlsutil.Reloader
+	var certIdentities *auth.CertificateIdentities
+	if serverTLS := cfg.Server.TLS; serverTLS.Enabled {
+		serverCerts, err = tlsutil.NewReloader(serverTLS.CertFile, serverTLS.KeyFile, serverTLS.ClientCAFile, logger)
+		if err != nil {
+			stopWatchers()
+			return nil, fmt.Errorf("invalid server TLS configuration: %w", err)
+		}
+		if _, err := tlsutil.ServerConfig(serverTLS, serverCerts); err != nil {
+			stopWatchers()
+			return nil, fmt.Errorf("invalid server TLS configuration: %w", err)
+		}
+		go serverCerts.Watch(watchCtx, serverTLS.ReloadInterval)
+
+		if len(serverTLS.ClientIdentities) > 0 {
+			if serverTLS.ClientCAFile == "" {
+				stopWatchers()
+				return nil, fmt.Errorf("server.tls.client_identities require server.tls.client_ca_file")
+			}
+			certIdentities, err = auth.NewCertificateIdentities(serverTLS.ClientIdentities)
+			if err != nil {
+				stopWatchers()
+				return nil, fmt.Errorf("invalid server TLS configuration: %w", err)
+			}
+			authOptions = append(authOptions, middleware.WithCertificateIdentities(certIdentities))
+		}
+		logger.Info("TLS enabled for HTTP and gRPC listeners",
+			"client_auth", serverTLS.ClientAuth,
+			"client_ca", serverTLS.ClientCAFile != "",
+			"client_identities", len(serverTLS.ClientIdentities),
+		)
+	} else if len(cfg.Server.TLS.ClientIdentities) > 0 {
+		logger.Warn("server.tls.client_identities are ignored because TLS is disabled")
+	}
 
 	// Initialize blockchain gateway
 	var blockchainGateway *blockchain.Gateway
@@ -82,6 +327,7 @@ func New(cfg *config.Config, logger *logger.Logger) (*Gateway, error) {
 	var mqttAdapter *mqtt.Adapter
 	if cfg.MQTT.Enabled && cfg.DeviceManagement.Enabled {
 		mqttAdapter = mqtt.NewAdapter(&cfg.MQTT, &cfg.DeviceManagement, logger)
+		mqttAdapter.SetObserver(gatewayMetrics.MQTTMessage)
 		if err := mqttAdapter.Connect(); err != nil {
 			logger.Warn("Failed to connect MQTT adapter", "error", err)
 		} else {
@@ -97,6 +343,22 @@ func New(cfg *config.Config, logger *logger.Logger) (*Gateway, error) {
 		registry:          consulRegistry,
 		blockchainGateway: blockchainGateway,
 		mqttAdapter:       mqttAdapter,
+		jwtVerifier:       jwtVerifier,
+		authCache:         authCache,
+		authzPolicy:       authzPolicy,
+		authzRules:        authzRules,
+		rateLimits:        rateLimits,
+		rateLimiter:       rateLimiter,
+		eventBus:          eventBus,
+		stopWatchers:      stopWatchers,
+		serverCerts:       serverCerts,
+		certIdentities:    certIdentities,
+		authOptions:       authOptions,
+		metrics:           gatewayMetrics,
+		shutdownTracing:   shutdownTracing,
+		accessLog:         accessLog,
+		auditor:           auditor,
+		grpcForwarder:     grpcForwarder,
 	}, nil
 }
 
@@ -105,10 +367,23 @@ func (g *Gateway) SetupHTTPRoutes() *gin.Engine {
 	// Create router
 	router := gin.New()
 
+	// Only trusted proxies may set the client IP through forwarding headers
+	if err := router.SetTrustedProxies(g.config.Server.TrustedProxies); err != nil {
+		g.logger.Error("Invalid trusted proxies, forwarding headers are ignored", "error", err)
+		router.SetTrustedProxies(nil)
+	}
+
 	// Add middleware
 	router.Use(gin.Recovery())
-	router.Use(middleware.RequestLogger(g.logger))
+	router.Use(tracing.Middleware())
+	if g.accessLog != nil {
+		router.Use(g.accessLog.Middleware())
+	} else {
+		router.Use(middleware.RequestLogger(g.logger))
+	}
 	router.Use(middleware.RequestID())
+	router.Use(g.metrics.Middleware())
+	router.Use(middleware.NormalizePath())
 	
 	// CORS middleware
 	if g.config.Security.CORS.Enabled {
@@ -122,12 +397,14 @@ func (g *Gateway) SetupHTTPRoutes() *gin.Engine {
 		router.Use(cors.New(corsConfig))
 	}
 
-	// Rate limiting middleware
-	if g.config.Security.RateLimit.Enabled {
-		router.Use(middleware.RateLimit(
-			g.config.Security.RateLimit.RPS,
-			g.config.Security.RateLimit.Burst,
-		))
+	// Authentication shared by management routes and proxied routes,
+	// followed by rate limiting per authenticated caller
+	authenticate := middleware.UnifiedAuthentication(g.clients.Auth, g.registry, g.logger, g.authOptions...)
+	authenticated := []gin.HandlerFunc{authenticate}
+	if g.rateLimiter != nil {
+		rateLimit := middleware.RateLimit(g.rateLimits, g.rateLimiter, g.logger)
+		authenticated = append(authenticated, rateLimit)
+		g.dynamicProxy.SetRateLimiter(rateLimit)
 	}
 
 	// Health check
@@ -136,15 +413,16 @@ func (g *Gateway) SetupHTTPRoutes() *gin.Engine {
 
 	// Gateway management routes (these don't go through the proxy)
 	gateway := router.Group("/api/v1/gateway")
-	gateway.Use(middleware.UnifiedAuthentication(g.clients.Auth, g.registry, g.logger))
+	gateway.Use(authenticated...)
 	gateway.GET("/services", g.listServices)
 	gateway.GET("/metrics", g.getMetrics)
 	gateway.GET("/health", g.servicesHealth)
+	gateway.GET("/authorization/explain", g.explainAuthorization)
 	
 	// Blockchain routes (if blockchain gateway is available)
 	if g.blockchainGateway != nil {
 		blockchainAPI := router.Group("/api/v1/blockchain")
-		blockchainAPI.Use(middleware.UnifiedAuthentication(g.clients.Auth, g.registry, g.logger))
+		blockchainAPI.Use(authenticated...)
 		
 		// Blockchain endpoints
 		blockchainAPI.GET("/status", g.blockchainStatus)
@@ -157,7 +435,7 @@ func (g *Gateway) SetupHTTPRoutes() *gin.Engine {
 	// MQTT and Device Management routes (if MQTT adapter is available)
 	if g.mqttAdapter != nil {
 		deviceAPI := router.Group("/api/v1/devices")
-		deviceAPI.Use(middleware.UnifiedAuthentication(g.clients.Auth, g.registry, g.logger))
+		deviceAPI.Use(authenticated...)
 		
 		// Device management endpoints
 		deviceAPI.GET("/mqtt/status", g.mqttStatus)
@@ -167,16 +445,74 @@ func (g *Gateway) SetupHTTPRoutes() *gin.Engine {
 	}
 	
 	// Set up dynamic proxy for service routes
-	// Use NoRoute to handle all unmatched requests for dynamic service discovery
+	// Use NoRoute to handle all unmatched requests; the route table decides the target service
+	g.dynamicProxy.SetAuthenticator(authenticate)
 	router.NoRoute(g.dynamicProxy.Handler())
 
 	return router
 }
 
-// RegisterGRPCServices registers gRPC services
-func (g *Gateway) RegisterGRPCServices(server *grpc.Server) {
-	// Register gateway management services
-	// Note: We'll implement these as needed
+// validateTrustedProxies checks that every trusted proxy is an IP or a CIDR
+func validateTrustedProxies(proxies []string) error {
+	for _, trusted := range proxies {
+		if _, _, err := net.ParseCIDR(trusted); err == nil {
+			continue
+		}
+		if net.ParseIP(trusted) == nil {
+			return fmt.Errorf("trusted proxy %q is neither an IP nor a CIDR", trusted)
+		}
+	}
+	return nil
+}
+
+// TLSConfig returns the listener TLS configuration, or nil when TLS is
+// disabled. nextProtos are the ALPN protocols the listener serves
+func (g *Gateway) TLSConfig(nextProtos ...string) (*tls.Config, error) {
+	if g.serverCerts == nil {
+		return nil, nil
+	}
+	return tlsutil.ServerConfig(g.config.Server.TLS, g.serverCerts, nextProtos...)
+}
+
+// grpcPeerService resolves the internal service of a gRPC caller from its
+// verified client certificate, for the interceptor logs
+func (g *Gateway) grpcPeerService(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return "", false
+	}
+	return g.certIdentities.Resolve(&info.State)
+}
+
+// RegisterGRPCServices registers the api/proto services, forwarded to their
+// backends, and the standard health service. Calls are admitted by the same
+// authentication, rate limiting and metrics middleware as HTTP requests, and
+// authenticated when the HTTP route of their method requires it
+func (g *Gateway) RegisterGRPCServices(server *grpc.Server) error {
+	var admission []gin.HandlerFunc
+	if g.accessLog != nil {
+		admission = append(admission, g.accessLog.Middleware())
+	}
+	admission = append(admission,
+		middleware.RequestID(),
+		g.metrics.Middleware(),
+	)
+	g.grpcForwarder.SetAdmission(admission...)
+	g.grpcForwarder.SetRouter(g.dynamicProxy.RouteAuthRequired)
+	g.grpcForwarder.SetAuthenticator(middleware.UnifiedAuthentication(g.clients.Auth, g.registry, g.logger, g.authOptions...))
+	if g.rateLimiter != nil {
+		g.grpcForwarder.SetRateLimiter(middleware.RateLimit(g.rateLimits, g.rateLimiter, g.logger))
+	}
+	g.grpcForwarder.SetIdentityForwarder(g.dynamicProxy.ForwardIdentity)
+
+	if err := g.grpcForwarder.Register(server); err != nil {
+		return fmt.Errorf("failed to register gRPC services: %w", err)
+	}
+	return nil
 }
 
 // GRPCUnaryInterceptor returns a gRPC unary interceptor
@@ -184,9 +520,14 @@ func (g *Gateway) GRPCUnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		start := time.Now()
 		
+		// Forwarded calls are authenticated by the admission middleware, which
+		// resolves the same certificate identity from the peer's TLS state
+		service, _ := g.grpcPeerService(ctx)
+		
 		// Log request
 		g.logger.Debug("gRPC request", 
 			"method", info.FullMethod,
+			"service", service,
 			"start_time", start,
 		)
 
@@ -217,8 +558,11 @@ func (g *Gateway) GRPCStreamInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		start := time.Now()
 		
+		service, _ := g.grpcPeerService(stream.Context())
+		
 		g.logger.Debug("gRPC stream started", 
 			"method", info.FullMethod,
+			"service", service,
 			"start_time", start,
 		)
 
@@ -246,12 +590,62 @@ func (g *Gateway) GRPCStreamInterceptor() grpc.StreamServerInterceptor {
 func (g *Gateway) Shutdown(ctx context.Context) error {
 	g.logger.Info("Shutting down gateway...")
 	
+	// Tell gRPC health checking clients to move away
+	g.grpcForwarder.Shutdown()
+	
 	// Disconnect MQTT adapter
 	if g.mqttAdapter != nil {
 		g.mqttAdapter.Disconnect()
 		g.logger.Info("MQTT adapter disconnected")
 	}
 	
+	// Stop Consul catalog watches
+	if g.registry != nil {
+		g.registry.Close()
+		g.logger.Info("Service catalog watches stopped")
+	}
+	
+	// Stop JWKS refreshes
+	if g.jwtVerifier != nil {
+		g.jwtVerifier.Close()
+	}
+	
+	// Publish queued audit events before the event bus goes away
+	if err := g.auditor.Close(ctx); err != nil {
+		g.logger.Warn("Failed to publish queued audit events", "error", err)
+	}
+	
+	// Stop event bus subscriptions and release the auth cache
+	g.stopWatchers()
+	if g.eventBus != nil {
+		g.eventBus.Close()
+	}
+	if g.authCache != nil {
+		if err := g.authCache.Close(); err != nil {
+			g.logger.Warn("Failed to close auth cache", "error", err)
+		}
+	}
+	if closer, ok := g.rateLimiter.(interface{ Close() error }); ok {
+		if err := closer.Close(); err != nil {
+			g.logger.Warn("Failed to close rate limiter", "error", err)
+		}
+	}
+	
+	// Release pooled upstream connections
+	g.dynamicProxy.Close()
+	g.grpcForwarder.Close()
+	
+	// Flush buffered spans
+	if err := g.shutdownTracing(ctx); err != nil {
+		g.logger.Warn("Failed to flush traces", "error", err)
+	}
+	
+	if g.accessLog != nil {
+		if err := g.accessLog.Close(); err != nil {
+			g.logger.Warn("Failed to close access log", "error", err)
+		}
+	}
+	
 	// Close service clients
 	if err := g.clients.Close(); err != nil {
 		g.logger.Error("Failed to close service clients", "error", err)
@@ -365,27 +759,69 @@ func (g *Gateway) listServices(c *gin.Context) {
 	})
 }
 
-// Get metrics endpoint
+// Get metrics endpoint: a JSON summary of the Prometheus metrics
 func (g *Gateway) getMetrics(c *gin.Context) {
-	// TODO: Implement actual metrics collection
+	summary, err := g.metrics.Summary()
+	if err != nil {
+		g.logger.Error("Failed to gather metrics", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to gather metrics"})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"gateway": map[string]interface{}{
-			"uptime":           time.Since(time.Now()).String(),
-			"total_requests":   0,
-			"active_requests":  0,
-			"error_rate":       0.0,
-			"average_latency":  "0ms",
-		},
-		"services": map[string]interface{}{
-			"user_service":  map[string]interface{}{"requests": 0, "errors": 0},
-			"auth_service":  map[string]interface{}{"requests": 0, "errors": 0},
-			"agent_service": map[string]interface{}{"requests": 0, "errors": 0},
-			"model_service": map[string]interface{}{"requests": 0, "errors": 0},
-			"mcp_service":   map[string]interface{}{"requests": 0, "errors": 0},
+		"gateway": gin.H{
+			"uptime":          summary.Uptime,
+			"uptime_seconds":  summary.UptimeSeconds,
+			"total_requests":  summary.TotalRequests,
+			"active_requests": summary.ActiveRequests,
+			"error_rate":      summary.ErrorRate,
+			"average_latency": fmt.Sprintf("%.1fms", summary.AvgLatencyMs),
+			"status_classes":  summary.StatusClasses,
 		},
+		"services":            summary.Services,
+		"mqtt_messages":       summary.MQTTMessages,
+		"event_bus_publishes": summary.EventPublishes,
 	})
 }
 
+// MetricsHandler serves the gateway metrics in the Prometheus format
+func (g *Gateway) MetricsHandler() http.Handler {
+	return g.metrics.Handler()
+}
+
+// explainAuthorization is a dry run of the authorization rules: it reports
+// which rule matches ?method=&path= and the permission that would be checked
+func (g *Gateway) explainAuthorization(c *gin.Context) {
+	path := c.Query("path")
+	if path == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "path query parameter is required"})
+		return
+	}
+	// Requests are matched on their cleaned path, see middleware.NormalizePath
+	path, err := auth.CleanPath(path)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	method := c.DefaultQuery("method", http.MethodGet)
+
+	match, evaluations := g.authzRules.Explain(method, path)
+	response := gin.H{
+		"method":         strings.ToUpper(method),
+		"path":           path,
+		"matched":        match != nil,
+		"evaluated":      evaluations,
+		"failure_policy": g.authzPolicy.PolicyFor(path),
+	}
+	if match != nil {
+		response["permission"] = match
+	} else {
+		response["permission"] = nil
+		response["message"] = "no rule matches, the request needs no permission check"
+	}
+	c.JSON(http.StatusOK, response)
+}
+
 // Services health endpoint
 func (g *Gateway) servicesHealth(c *gin.Context) {
 	health := g.dynamicProxy.HealthCheck()
@@ -403,11 +839,31 @@ func (g *Gateway) servicesHealth(c *gin.Context) {
 		status = http.StatusServiceUnavailable
 	}
 	
-	c.JSON(status, gin.H{
+	response := gin.H{
 		"healthy":  allHealthy,
 		"services": health,
 		"timestamp": time.Now().UTC(),
-	})
+	}
+	if g.registry != nil {
+		response["catalog"] = g.registry.CatalogStatus()
+	}
+	response["circuit_breakers"] = g.dynamicProxy.BreakerStatus()
+	response["streams"] = g.dynamicProxy.StreamStats()
+	if g.jwtVerifier != nil {
+		response["jwt_verification"] = g.jwtVerifier.Status()
+	}
+	if g.authCache != nil {
+		response["auth_cache"] = g.authCache.Stats()
+	}
+	response["authorization_fallbacks"] = g.authzPolicy.Stats()
+	if g.rateLimiter != nil {
+		response["rate_limit"] = g.rateLimiter.Stats()
+	}
+	if g.serverCerts != nil {
+		response["server_tls"] = g.serverCerts.Status()
+	}
+	
+	c.JSON(status, response)
 }
 
 // MQTT status endpoint
@@ -467,7 +923,17 @@ func (g *Gateway) sendDeviceCommand(c *gin.Context) {
 	command["timestamp"] = time.Now().UTC().Format(time.RFC3339)
 	command["source"] = "gateway"
 
-	if err := g.mqttAdapter.SendCommandToDevice(deviceID, command); err != nil {
+	err := g.mqttAdapter.SendCommandToDevice(c.Request.Context(), deviceID, command)
+	record := audit.FromRequest(c, audit.ActionDeviceCommand)
+	record.Resource = "device:" + deviceID
+	record.Decision = audit.DecisionAllow
+	record.Details = map[string]interface{}{"command": command, "delivered": err == nil}
+	if err != nil {
+		record.Reason = err.Error()
+	}
+	g.auditor.Record(c.Request.Context(), record)
+
+	if err != nil {
 		g.logger.Error("Failed to send device command", 
 			"device_id", deviceID, 
 			"error", err,
@@ -0,0 +1,589 @@
+// Package grpcproxy serves the gRPC services of api/proto on the gateway and
+// forwards every call to the backend that implements it.
+//
+// Calls are admitted by the same gin middleware as HTTP requests, run
+// against a synthetic request: the HTTP method and path the gRPC method is
+// mapped to in httpRoutes, with the incoming metadata as headers and the
+// peer address and TLS state of the caller. The HTTP route table decides
+// whether the call is authenticated, except for the methods in
+// authenticatedMethods, which always are, and authorization rules and policies
+// and rate limits treat /isa.mcp.MCPService/ExecuteSQL like a POST to
+// /api/v1/mcp/tools/call/ExecuteSQL. Metrics label calls with the full
+// method name. HMAC service signatures for gRPC calls are computed over the
+// mapped method and path, with the deterministic binary encoding of the
+// request message as the body, so a signature only admits the message it
+// was made for.
+//
+// Backends are static: each service is reached at the host and grpc_port of
+// its services entry, over one client connection. Unlike HTTP requests,
+// gRPC calls are not resolved through Consul, load balanced across
+// instances, circuit broken or retried; put a load balancer behind the
+// configured address where that is needed
+package grpcproxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tlsutil"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/agent"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/auth"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/mcp"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/model"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/user"
+)
+
+// service is a gRPC service of api/proto and the backend implementing it
+type service struct {
+	name     string // service name used in metrics and logs, as in HTTP routes
+	desc     *grpc.ServiceDesc
+	endpoint func(*config.ServicesConfig) *config.ServiceEndpoint
+}
+
+var services = []service{
+	{"users", &user.UserService_ServiceDesc, func(s *config.ServicesConfig) *config.ServiceEndpoint { return &s.UserService }},
+	{"auth", &auth.AuthService_ServiceDesc, func(s *config.ServicesConfig) *config.ServiceEndpoint { return &s.AuthService }},
+	{"agents", &agent.AgentService_ServiceDesc, func(s *config.ServicesConfig) *config.ServiceEndpoint { return &s.AgentService }},
+	{"models", &model.ModelService_ServiceDesc, func(s *config.ServicesConfig) *config.ServiceEndpoint { return &s.ModelService }},
+	{"mcp", &mcp.MCPService_ServiceDesc, func(s *config.ServicesConfig) *config.ServiceEndpoint { return &s.MCPService }},
+}
+
+// Observer receives forwarding events, e.g. to export metrics. Every hook is
+// optional
+type Observer struct {
+	// RequestStarted is called once a call is admitted for routing; the
+	// returned function is called when it completes
+	RequestStarted func(service, route string) func()
+
+	// UpstreamError is called for every failed backend call. reason is
+	// timeout or connection
+	UpstreamError func(service, reason string)
+}
+
+// backend is the connection to one backend service
+type backend struct {
+	service string
+	target  string
+	timeout time.Duration
+	conn    *grpc.ClientConn
+}
+
+// Forwarder forwards calls to the gRPC services of api/proto
+type Forwarder struct {
+	logger   *logger.Logger
+	backends []*backend
+	byName   map[string]*backend // by gRPC service name
+	health   *health.Server
+
+	// Gin engine running the admission middleware before each call
+	admission     *gin.Engine
+	authenticator gin.HandlerFunc
+	rateLimiter   gin.HandlerFunc
+	identity      gin.HandlerFunc
+	router        func(*http.Request) (authRequired bool, ok bool)
+	observer      Observer
+}
+
+// callKey carries the call being admitted through the gin engine
+type callKey struct{}
+
+type call struct {
+	fullMethod string
+	route      httpRoute
+	routed     bool // route is the HTTP equivalent; unrouted methods are rejected
+	backend    *backend
+	in, out    proto.Message
+
+	forwarded bool
+	err       error
+	header    metadata.MD
+	trailer   metadata.MD
+}
+
+// New connects to the static backends of the services with a gRPC port
+// configured. clientTLS may be nil to reach backends in plaintext. Connections are
+// established lazily, on the first call
+func New(cfg *config.Config, clientTLS *tlsutil.ClientTLS, logger *logger.Logger) (*Forwarder, error) {
+	f := &Forwarder{
+		logger: logger,
+		byName: make(map[string]*backend, len(services)),
+		health: health.NewServer(),
+	}
+	for _, svc := range services {
+		endpoint := svc.endpoint(&cfg.Services)
+		if endpoint.GRPCPort == 0 {
+			logger.Info("No gRPC port configured, gRPC service not served", "service", svc.desc.ServiceName)
+			continue
+		}
+
+		target := net.JoinHostPort(endpoint.Host, strconv.Itoa(endpoint.GRPCPort))
+		creds := insecure.NewCredentials()
+		if clientTLS != nil {
+			creds = credentials.NewTLS(clientTLS.Config(endpoint.Host))
+		}
+		conn, err := grpc.NewClient(target,
+			grpc.WithTransportCredentials(creds),
+			grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor()),
+		)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("invalid gRPC backend for %s: %w", svc.desc.ServiceName, err)
+		}
+
+		b := &backend{service: svc.name, target: target, timeout: endpoint.Timeout, conn: conn}
+		f.backends = append(f.backends, b)
+		f.byName[svc.desc.ServiceName] = b
+	}
+	f.SetAdmission()
+	return f, nil
+}
+
+// SetAdmission sets the middleware run before every call, in order, ahead
+// of authentication and rate limiting. A handler rejects a call by aborting
+// the context; the response status is translated to a gRPC code
+func (f *Forwarder) SetAdmission(handlers ...gin.HandlerFunc) {
+	engine := gin.New()
+	engine.SetTrustedProxies(nil)
+	engine.Use(f.describe)
+	engine.Use(handlers...)
+	engine.NoRoute(f.forward)
+	f.admission = engine
+}
+
+// SetRouter sets the lookup in the HTTP route table deciding whether a call
+// needs authentication. Without one every call is authenticated, and the
+// methods in authenticatedMethods always are
+func (f *Forwarder) SetRouter(router func(*http.Request) (authRequired bool, ok bool)) {
+	f.router = router
+}
+
+// SetAuthenticator sets the handler authenticating calls whose route
+// requires it
+func (f *Forwarder) SetAuthenticator(authenticator gin.HandlerFunc) {
+	f.authenticator = authenticator
+}
+
+// SetRateLimiter sets the handler enforcing rate limits on calls
+func (f *Forwarder) SetRateLimiter(rateLimiter gin.HandlerFunc) {
+	f.rateLimiter = rateLimiter
+}
+
+// SetIdentityForwarder sets the handler run on admitted calls to sign the
+// caller identity; headers it sets are forwarded as metadata
+func (f *Forwarder) SetIdentityForwarder(forward gin.HandlerFunc) {
+	f.identity = forward
+}
+
+// SetObserver sets the hooks told about forwarded calls and backend failures
+func (f *Forwarder) SetObserver(observer Observer) {
+	f.observer = observer
+}
+
+// Register registers the forwarded services and the standard health service
+// on server
+func (f *Forwarder) Register(server *grpc.Server) error {
+	for _, svc := range services {
+		b, ok := f.byName[svc.desc.ServiceName]
+		if !ok {
+			continue
+		}
+		desc, err := f.serviceDesc(svc.desc, b)
+		if err != nil {
+			return err
+		}
+		// The forwarder is not a typed implementation; with a nil
+		// implementation the server skips the HandlerType check
+		server.RegisterService(desc, nil)
+		f.health.SetServingStatus(svc.desc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+		f.logger.Info("Serving gRPC service", "service", svc.desc.ServiceName, "backend", b.target, "discovery", "static")
+	}
+	f.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, f.health)
+	return nil
+}
+
+// Shutdown reports every service as not serving, so health checking clients
+// move away before the server stops
+func (f *Forwarder) Shutdown() {
+	f.health.Shutdown()
+}
+
+// Close closes the backend connections
+func (f *Forwarder) Close() {
+	for _, b := range f.backends {
+		b.conn.Close()
+	}
+}
+
+// serviceDesc copies a generated service description, replacing the handlers
+// with ones that forward to b. Request and response types are looked up in
+// the registry of the generated code
+func (f *Forwarder) serviceDesc(generated *grpc.ServiceDesc, b *backend) (*grpc.ServiceDesc, error) {
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(generated.ServiceName))
+	if err != nil {
+		return nil, fmt.Errorf("gRPC service %s is not registered: %w", generated.ServiceName, err)
+	}
+	methods := descriptor.(protoreflect.ServiceDescriptor).Methods()
+
+	desc := *generated
+	desc.HandlerType = nil
+	desc.Methods = make([]grpc.MethodDesc, 0, len(generated.Methods))
+	for _, method := range generated.Methods {
+		md := methods.ByName(protoreflect.Name(method.MethodName))
+		if md == nil {
+			return nil, fmt.Errorf("gRPC method %s/%s has no descriptor", generated.ServiceName, method.MethodName)
+		}
+		input, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+		if err != nil {
+			return nil, fmt.Errorf("gRPC method %s/%s: %w", generated.ServiceName, method.MethodName, err)
+		}
+		output, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+		if err != nil {
+			return nil, fmt.Errorf("gRPC method %s/%s: %w", generated.ServiceName, method.MethodName, err)
+		}
+		fullMethod := "/" + generated.ServiceName + "/" + method.MethodName
+		if _, ok := httpRoutes[fullMethod]; !ok {
+			f.logger.Warn("gRPC method has no HTTP route and is rejected", "method", fullMethod)
+		}
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    f.handler(fullMethod, b, input, output),
+		})
+	}
+	return &desc, nil
+}
+
+// handler is the method handler of one forwarded method. It runs the server
+// interceptors like a generated handler
+func (f *Forwarder) handler(fullMethod string, b *backend, input, output protoreflect.MessageType) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	route, routed := httpRoutes[fullMethod]
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := input.New().Interface()
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		forward := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return f.call(ctx, &call{
+				fullMethod: fullMethod,
+				route:      route,
+				routed:     routed,
+				backend:    b,
+				in:         req.(proto.Message),
+				out:        output.New().Interface(),
+			})
+		}
+		if interceptor == nil {
+			return forward(ctx, in)
+		}
+		return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}, forward)
+	}
+}
+
+// call admits cl through the gin engine, which forwards it once every
+// middleware has passed
+func (f *Forwarder) call(ctx context.Context, cl *call) (interface{}, error) {
+	route := cl.route
+	if !cl.routed {
+		route = httpRoute{http.MethodPost, cl.fullMethod}
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(cl.in)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request message: %v", err)
+	}
+	req := admissionRequest(context.WithValue(ctx, callKey{}, cl), route, body)
+	w := &responseRecorder{header: make(http.Header)}
+	f.admission.ServeHTTP(w, req)
+
+	// Headers set by the gateway, e.g. the request ID and rate limits,
+	// go out with those of the backend
+	header := metadata.MD{}
+	for name, values := range w.header {
+		if name != "Content-Type" {
+			header.Append(strings.ToLower(name), values...)
+		}
+	}
+	header = metadata.Join(header, cl.header)
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		f.logger.Debug("Failed to set gRPC response headers", "method", cl.fullMethod, "error", err)
+	}
+	if len(cl.trailer) > 0 {
+		grpc.SetTrailer(ctx, cl.trailer)
+	}
+
+	if !cl.forwarded {
+		return nil, status.Error(codeFromHTTP(w.status), rejection(w.body.Bytes(), w.status))
+	}
+	if cl.err != nil {
+		return nil, cl.err
+	}
+	return cl.out, nil
+}
+
+// describe names the call for the middleware that follows, like the route
+// table does for HTTP requests
+func (f *Forwarder) describe(c *gin.Context) {
+	cl := c.Request.Context().Value(callKey{}).(*call)
+	c.Set("route", cl.fullMethod)
+	c.Set("service", cl.backend.service)
+	if f.observer.RequestStarted != nil {
+		done := f.observer.RequestStarted(cl.backend.service, cl.fullMethod)
+		defer done()
+	}
+	c.Next()
+}
+
+// forward authenticates and rate limits the call as its HTTP route would be,
+// then calls the backend
+func (f *Forwarder) forward(c *gin.Context) {
+	cl := c.Request.Context().Value(callKey{}).(*call)
+	if !cl.routed {
+		c.JSON(http.StatusForbidden, gin.H{"error": "method not exposed by the gateway"})
+		return
+	}
+
+	authRequired := true
+	if f.router != nil {
+		required, ok := f.router(c.Request)
+		if !ok {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Service not found"})
+			return
+		}
+		authRequired = required || authenticatedMethods[cl.fullMethod]
+	}
+	if authRequired {
+		if f.authenticator == nil {
+			f.logger.Error("gRPC method requires authentication but no authenticator is configured", "method", cl.fullMethod)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "authentication unavailable"})
+			return
+		}
+		authStart := time.Now()
+		f.authenticator(c)
+		c.Set("auth_latency", time.Since(authStart))
+		if c.IsAborted() {
+			return
+		}
+	}
+	if f.rateLimiter != nil {
+		f.rateLimiter(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+	if f.identity != nil {
+		f.identity(c)
+	}
+
+	ctx := metadata.NewOutgoingContext(c.Request.Context(), outgoingMetadata(c.Request))
+	if cl.backend.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cl.backend.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	cl.err = cl.backend.conn.Invoke(ctx, cl.fullMethod, cl.in, cl.out, grpc.Header(&cl.header), grpc.Trailer(&cl.trailer))
+	cl.forwarded = true
+	c.Set("upstream_instance", cl.backend.target)
+	c.Set("upstream_attempts", 1)
+	c.Set("upstream_latency", time.Since(start))
+
+	code := status.Code(cl.err)
+	if f.observer.UpstreamError != nil {
+		switch code {
+		case codes.DeadlineExceeded:
+			f.observer.UpstreamError(cl.backend.service, "timeout")
+		case codes.Unavailable:
+			f.observer.UpstreamError(cl.backend.service, "connection")
+		}
+	}
+	if cl.err != nil {
+		f.logger.Debug("gRPC backend call failed",
+			"service", cl.backend.service,
+			"method", cl.fullMethod,
+			"code", code.String(),
+			"error", cl.err,
+		)
+	}
+	c.Status(httpFromCode(code))
+	c.Writer.WriteHeaderNow()
+}
+
+// admissionRequest builds the HTTP request the admission middleware sees for
+// a gRPC call admitted as route. body is the encoded request message, which
+// service signatures are checked against
+func admissionRequest(ctx context.Context, route httpRoute, body []byte) *http.Request {
+	req, _ := http.NewRequestWithContext(ctx, route.method, route.path, bytes.NewReader(body))
+	req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2.0", 2, 0
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for name, values := range md {
+		switch {
+		case name == ":authority":
+			if len(values) > 0 {
+				req.Host = values[0]
+			}
+		case strings.HasPrefix(name, ":"), strings.HasSuffix(name, "-bin"):
+		default:
+			for _, value := range values {
+				req.Header.Add(name, value)
+			}
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			req.RemoteAddr = p.Addr.String()
+		}
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			req.TLS = &info.State
+		}
+	}
+	return req
+}
+
+// reservedMetadata are headers gRPC sets itself on outgoing calls
+var reservedMetadata = map[string]bool{
+	"content-type": true,
+	"user-agent":   true,
+	"te":           true,
+	"connection":   true,
+	"host":         true,
+}
+
+// outgoingMetadata forwards the headers of the admitted request, including
+// those set by the admission middleware such as the request ID and signed
+// identity, along with binary metadata of the caller
+func outgoingMetadata(req *http.Request) metadata.MD {
+	md := metadata.MD{}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if reservedMetadata[name] || strings.HasPrefix(name, "grpc-") {
+			continue
+		}
+		md.Append(name, values...)
+	}
+	incoming, _ := metadata.FromIncomingContext(req.Context())
+	for name, values := range incoming {
+		if strings.HasSuffix(name, "-bin") && !strings.HasPrefix(name, "grpc-") {
+			md.Append(name, values...)
+		}
+	}
+	return md
+}
+
+// rejection extracts the message of an error response written by the
+// admission middleware
+func rejection(body []byte, httpStatus int) string {
+	var response struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &response) == nil {
+		switch {
+		case response.Error != "" && response.Message != "":
+			return response.Error + ": " + response.Message
+		case response.Error != "":
+			return response.Error
+		}
+	}
+	return http.StatusText(httpStatus)
+}
+
+// codeFromHTTP translates the status of a rejected call
+func codeFromHTTP(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
+
+// httpFromCode translates the code of a forwarded call into the status
+// recorded in metrics and the access log
+func httpFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // client closed request
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// responseRecorder keeps the response the admission middleware writes
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(data)
+}
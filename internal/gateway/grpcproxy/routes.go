@@ -0,0 +1,100 @@
+package grpcproxy
+
+import (
+	"net/http"
+
+	"github.com/isa-cloud/isa_cloud/pkg/proto/agent"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/auth"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/mcp"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/model"
+	"github.com/isa-cloud/isa_cloud/pkg/proto/user"
+)
+
+// httpRoute is the HTTP request a gRPC method is admitted as
+type httpRoute struct {
+	method string
+	path   string
+}
+
+// httpRoutes gives every forwarded method the HTTP method and path of its
+// HTTP counterpart, so the route table, authorization rules and policies
+// and per-route rate limits keyed on /api/v1 paths apply to it. Methods
+// that run tools, change resources or hold agent conversations go under
+// /api/v1/mcp/tools/call and /api/v1/agents/api/chat, which require
+// read_write access. Methods missing here are rejected
+var httpRoutes = map[string]httpRoute{
+	user.UserService_GetUser_FullMethodName:            {http.MethodGet, "/api/v1/users/GetUser"},
+	user.UserService_CreateUser_FullMethodName:         {http.MethodPost, "/api/v1/users/CreateUser"},
+	user.UserService_UpdateUser_FullMethodName:         {http.MethodPut, "/api/v1/users/UpdateUser"},
+	user.UserService_DeleteUser_FullMethodName:         {http.MethodDelete, "/api/v1/users/DeleteUser"},
+	user.UserService_ListUsers_FullMethodName:          {http.MethodGet, "/api/v1/users/ListUsers"},
+	user.UserService_EnsureUserExists_FullMethodName:   {http.MethodPost, "/api/v1/users/EnsureUserExists"},
+	user.UserService_GetUserByAuth0ID_FullMethodName:   {http.MethodGet, "/api/v1/users/GetUserByAuth0ID"},
+	user.UserService_GetOrganization_FullMethodName:    {http.MethodGet, "/api/v1/users/GetOrganization"},
+	user.UserService_CreateOrganization_FullMethodName: {http.MethodPost, "/api/v1/users/CreateOrganization"},
+	user.UserService_ListOrganizations_FullMethodName:  {http.MethodGet, "/api/v1/users/ListOrganizations"},
+	user.UserService_ConsumeCredits_FullMethodName:     {http.MethodPost, "/api/v1/users/ConsumeCredits"},
+	user.UserService_GetCreditsBalance_FullMethodName:  {http.MethodGet, "/api/v1/users/GetCreditsBalance"},
+
+	auth.AuthService_VerifyToken_FullMethodName:      {http.MethodPost, "/api/v1/auth/VerifyToken"},
+	auth.AuthService_VerifyAPIKey_FullMethodName:     {http.MethodPost, "/api/v1/auth/VerifyAPIKey"},
+	auth.AuthService_GenerateDevToken_FullMethodName: {http.MethodPost, "/api/v1/auth/GenerateDevToken"},
+	auth.AuthService_GetUserInfo_FullMethodName:      {http.MethodGet, "/api/v1/auth/GetUserInfo"},
+	auth.AuthService_CreateAPIKey_FullMethodName:     {http.MethodPost, "/api/v1/auth/CreateAPIKey"},
+	auth.AuthService_ListAPIKeys_FullMethodName:      {http.MethodGet, "/api/v1/auth/ListAPIKeys"},
+	auth.AuthService_RevokeAPIKey_FullMethodName:     {http.MethodDelete, "/api/v1/auth/RevokeAPIKey"},
+
+	agent.AgentService_CreateAgent_FullMethodName:       {http.MethodPost, "/api/v1/agents/CreateAgent"},
+	agent.AgentService_GetAgent_FullMethodName:          {http.MethodGet, "/api/v1/agents/GetAgent"},
+	agent.AgentService_ListAgents_FullMethodName:        {http.MethodGet, "/api/v1/agents/ListAgents"},
+	agent.AgentService_UpdateAgent_FullMethodName:       {http.MethodPut, "/api/v1/agents/UpdateAgent"},
+	agent.AgentService_DeleteAgent_FullMethodName:       {http.MethodDelete, "/api/v1/agents/DeleteAgent"},
+	agent.AgentService_CreateSession_FullMethodName:     {http.MethodPost, "/api/v1/agents/api/chat/CreateSession"},
+	agent.AgentService_SendMessage_FullMethodName:       {http.MethodPost, "/api/v1/agents/api/chat/SendMessage"},
+	agent.AgentService_GetSessionHistory_FullMethodName: {http.MethodGet, "/api/v1/agents/GetSessionHistory"},
+	agent.AgentService_EndSession_FullMethodName:        {http.MethodPost, "/api/v1/agents/api/chat/EndSession"},
+	agent.AgentService_RunWorkflow_FullMethodName:       {http.MethodPost, "/api/v1/agents/api/chat/RunWorkflow"},
+	agent.AgentService_GetWorkflowStatus_FullMethodName: {http.MethodGet, "/api/v1/agents/GetWorkflowStatus"},
+	agent.AgentService_CancelWorkflow_FullMethodName:    {http.MethodPost, "/api/v1/agents/api/chat/CancelWorkflow"},
+	agent.AgentService_GetAgentHealth_FullMethodName:    {http.MethodGet, "/api/v1/agents/GetAgentHealth"},
+
+	model.ModelService_ListModels_FullMethodName:        {http.MethodGet, "/api/v1/models/ListModels"},
+	model.ModelService_GetModel_FullMethodName:          {http.MethodGet, "/api/v1/models/GetModel"},
+	model.ModelService_DeployModel_FullMethodName:       {http.MethodPost, "/api/v1/models/DeployModel"},
+	model.ModelService_UndeployModel_FullMethodName:     {http.MethodPost, "/api/v1/models/UndeployModel"},
+	model.ModelService_GetModelStatus_FullMethodName:    {http.MethodGet, "/api/v1/models/GetModelStatus"},
+	model.ModelService_GenerateText_FullMethodName:      {http.MethodPost, "/api/v1/models/GenerateText"},
+	model.ModelService_ChatCompletion_FullMethodName:    {http.MethodPost, "/api/v1/models/ChatCompletion"},
+	model.ModelService_GenerateEmbedding_FullMethodName: {http.MethodPost, "/api/v1/models/GenerateEmbedding"},
+	model.ModelService_BatchInference_FullMethodName:    {http.MethodPost, "/api/v1/models/BatchInference"},
+	model.ModelService_GetBatchStatus_FullMethodName:    {http.MethodGet, "/api/v1/models/GetBatchStatus"},
+	model.ModelService_GetModelHealth_FullMethodName:    {http.MethodGet, "/api/v1/models/GetModelHealth"},
+	model.ModelService_GetServiceHealth_FullMethodName:  {http.MethodGet, "/api/v1/models/GetServiceHealth"},
+
+	mcp.MCPService_ListResources_FullMethodName:     {http.MethodGet, "/api/v1/mcp/ListResources"},
+	mcp.MCPService_GetResource_FullMethodName:       {http.MethodGet, "/api/v1/mcp/GetResource"},
+	mcp.MCPService_CreateResource_FullMethodName:    {http.MethodPost, "/api/v1/mcp/tools/call/CreateResource"},
+	mcp.MCPService_UpdateResource_FullMethodName:    {http.MethodPost, "/api/v1/mcp/tools/call/UpdateResource"},
+	mcp.MCPService_DeleteResource_FullMethodName:    {http.MethodPost, "/api/v1/mcp/tools/call/DeleteResource"},
+	mcp.MCPService_QueryDatabase_FullMethodName:     {http.MethodPost, "/api/v1/mcp/tools/call/QueryDatabase"},
+	mcp.MCPService_ExecuteSQL_FullMethodName:        {http.MethodPost, "/api/v1/mcp/tools/call/ExecuteSQL"},
+	mcp.MCPService_QueryVectorDB_FullMethodName:     {http.MethodPost, "/api/v1/mcp/search/QueryVectorDB"},
+	mcp.MCPService_QueryGraphDB_FullMethodName:      {http.MethodPost, "/api/v1/mcp/search/QueryGraphDB"},
+	mcp.MCPService_UploadFile_FullMethodName:        {http.MethodPost, "/api/v1/mcp/tools/call/UploadFile"},
+	mcp.MCPService_DownloadFile_FullMethodName:      {http.MethodGet, "/api/v1/mcp/DownloadFile"},
+	mcp.MCPService_ListFiles_FullMethodName:         {http.MethodGet, "/api/v1/mcp/ListFiles"},
+	mcp.MCPService_DeleteFile_FullMethodName:        {http.MethodPost, "/api/v1/mcp/tools/call/DeleteFile"},
+	mcp.MCPService_CallExternalAPI_FullMethodName:   {http.MethodPost, "/api/v1/mcp/tools/call/CallExternalAPI"},
+	mcp.MCPService_GetAPIStatus_FullMethodName:      {http.MethodGet, "/api/v1/mcp/GetAPIStatus"},
+	mcp.MCPService_GetResourceHealth_FullMethodName: {http.MethodGet, "/api/v1/mcp/GetResourceHealth"},
+}
+
+// authenticatedMethods are always authenticated, whether or not the route
+// table requires it for their HTTP route. The auth service route is open
+// so tokens and API keys can be verified, but managing API keys needs a
+// caller
+var authenticatedMethods = map[string]bool{
+	auth.AuthService_CreateAPIKey_FullMethodName: true,
+	auth.AuthService_ListAPIKeys_FullMethodName:  true,
+	auth.AuthService_RevokeAPIKey_FullMethodName: true,
+}
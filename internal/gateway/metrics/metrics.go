@@ -0,0 +1,314 @@
+// Package metrics exports gateway metrics in the Prometheus format and
+// summarizes them for the gateway management API
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// Label values used when a request carries no service or auth method
+const (
+	gatewayService = "gateway"
+	unmatchedRoute = "unmatched"
+	noAuth         = "none"
+)
+
+// Metrics holds the gateway collectors on a private registry
+type Metrics struct {
+	registry  *prometheus.Registry
+	started   time.Time
+	namespace string
+	subsystem string
+
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	inFlight        *prometheus.GaugeVec
+	upstreamErrors  *prometheus.CounterVec
+	streams         *prometheus.CounterVec
+	streamDuration  *prometheus.HistogramVec
+	streamBytes     *prometheus.CounterVec
+	streamEvents    *prometheus.CounterVec
+	mqttMessages    *prometheus.CounterVec
+	eventPublishes  *prometheus.CounterVec
+	authzFallbacks  *prometheus.CounterVec
+	auditEvents     *prometheus.CounterVec
+}
+
+// New creates the gateway collectors under namespace and subsystem
+func New(namespace, subsystem string) *Metrics {
+	opts := func(name, help string) prometheus.Opts {
+		return prometheus.Opts{Namespace: namespace, Subsystem: subsystem, Name: name, Help: help}
+	}
+	histogram := func(name, help string, buckets []float64) prometheus.HistogramOpts {
+		return prometheus.HistogramOpts{Namespace: namespace, Subsystem: subsystem, Name: name, Help: help, Buckets: buckets}
+	}
+
+	m := &Metrics{
+		registry:  prometheus.NewRegistry(),
+		started:   time.Now(),
+		namespace: namespace,
+		subsystem: subsystem,
+
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"requests_total", "HTTP requests handled by the gateway")),
+			[]string{"service", "route", "method", "status", "auth_method"}),
+		requestDuration: prometheus.NewHistogramVec(histogram(
+			"request_duration_seconds", "Time to handle HTTP requests, including the upstream",
+			prometheus.DefBuckets),
+			[]string{"service", "route", "method", "status", "auth_method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts(opts(
+			"requests_in_flight", "HTTP requests currently being handled")),
+			[]string{"service", "route"}),
+		upstreamErrors: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"upstream_errors_total", "Failed upstream attempts by reason")),
+			[]string{"service", "reason"}),
+		streams: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"sse_streams_total", "SSE streams relayed, by how they ended")),
+			[]string{"service", "outcome"}),
+		streamDuration: prometheus.NewHistogramVec(histogram(
+			"sse_stream_duration_seconds", "Lifetime of relayed SSE streams",
+			[]float64{1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600}),
+			[]string{"service"}),
+		streamBytes: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"sse_bytes_total", "Bytes written to SSE clients")),
+			[]string{"service"}),
+		streamEvents: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"sse_events_total", "Events relayed to SSE clients")),
+			[]string{"service"}),
+		mqttMessages: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"mqtt_messages_total", "MQTT messages received (in) and published (out)")),
+			[]string{"direction", "result"}),
+		eventPublishes: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"event_bus_publish_total", "Events published to the event bus")),
+			[]string{"source", "type", "result"}),
+		authzFallbacks: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"authorization_fallbacks_total", "Authorization decisions made without the Authorization Service")),
+			[]string{"kind"}),
+		auditEvents: prometheus.NewCounterVec(prometheus.CounterOpts(opts(
+			"audit_events_total", "Security audit events published, failed or dropped from a full queue")),
+			[]string{"result"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests, m.requestDuration, m.inFlight, m.upstreamErrors,
+		m.streams, m.streamDuration, m.streamBytes, m.streamEvents,
+		m.mqttMessages, m.eventPublishes, m.authzFallbacks, m.auditEvents,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// Middleware counts and times every request. The service and route labels
+// come from the proxy when it handled the request, otherwise from the gin
+// route so unknown paths do not create new series
+func (m *Metrics) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		// Proxied requests are tracked by RequestStarted once routed
+		if route := c.FullPath(); route != "" {
+			gauge := m.inFlight.WithLabelValues(gatewayService, route)
+			gauge.Inc()
+			defer gauge.Dec()
+		}
+
+		c.Next()
+
+		service := c.GetString("service")
+		if service == "" {
+			service = gatewayService
+		}
+		route := c.GetString("route")
+		if route == "" {
+			route = c.FullPath()
+		}
+		if route == "" {
+			route = unmatchedRoute
+		}
+		authMethod := c.GetString("auth_method")
+		if authMethod == "" {
+			authMethod = noAuth
+		}
+
+		labels := []string{service, route, c.Request.Method, strconv.Itoa(c.Writer.Status()), authMethod}
+		m.requests.WithLabelValues(labels...).Inc()
+		m.requestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+	}
+}
+
+// RequestStarted marks a proxied request in flight until the returned
+// function is called
+func (m *Metrics) RequestStarted(service, route string) func() {
+	gauge := m.inFlight.WithLabelValues(service, route)
+	gauge.Inc()
+	return gauge.Dec
+}
+
+// UpstreamError counts a failed upstream attempt
+func (m *Metrics) UpstreamError(service, reason string) {
+	m.upstreamErrors.WithLabelValues(service, reason).Inc()
+}
+
+// StreamFinished records a relayed SSE stream
+func (m *Metrics) StreamFinished(service string, duration time.Duration, events, bytes int64, completed, disconnected bool) {
+	outcome := "error"
+	switch {
+	case completed:
+		outcome = "completed"
+	case disconnected:
+		outcome = "client_disconnected"
+	}
+	m.streams.WithLabelValues(service, outcome).Inc()
+	m.streamDuration.WithLabelValues(service).Observe(duration.Seconds())
+	m.streamBytes.WithLabelValues(service).Add(float64(bytes))
+	m.streamEvents.WithLabelValues(service).Add(float64(events))
+}
+
+// MQTTMessage counts an MQTT message
+func (m *Metrics) MQTTMessage(direction, result string) {
+	m.mqttMessages.WithLabelValues(direction, result).Inc()
+}
+
+// EventPublished counts an event bus publish
+func (m *Metrics) EventPublished(source, eventType string, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.eventPublishes.WithLabelValues(source, eventType, result).Inc()
+}
+
+// AuthorizationFallback counts an authorization decision made without the
+// Authorization Service
+func (m *Metrics) AuthorizationFallback(kind string) {
+	m.authzFallbacks.WithLabelValues(kind).Inc()
+}
+
+// AuditEvent counts a security audit event by result
+func (m *Metrics) AuditEvent(result string) {
+	m.auditEvents.WithLabelValues(result).Inc()
+}
+
+// ServiceSummary is the traffic of one service
+type ServiceSummary struct {
+	Requests       uint64  `json:"requests"`
+	Errors         uint64  `json:"errors"` // 5xx responses
+	UpstreamErrors uint64  `json:"upstream_errors"`
+	AvgLatencyMs   float64 `json:"avg_latency_ms"`
+}
+
+// Summary is an overview of the gateway metrics for the management API
+type Summary struct {
+	Uptime         string                     `json:"uptime"`
+	UptimeSeconds  int64                      `json:"uptime_seconds"`
+	TotalRequests  uint64                     `json:"total_requests"`
+	ActiveRequests int64                      `json:"active_requests"`
+	ErrorRate      float64                    `json:"error_rate"`
+	AvgLatencyMs   float64                    `json:"avg_latency_ms"`
+	StatusClasses  map[string]uint64          `json:"status_classes"`
+	Services       map[string]*ServiceSummary `json:"services"`
+	MQTTMessages   map[string]uint64          `json:"mqtt_messages"`
+	EventPublishes map[string]uint64          `json:"event_bus_publishes"`
+}
+
+// Summary gathers the current values into an overview
+func (m *Metrics) Summary() (*Summary, error) {
+	families, err := m.registry.Gather()
+	if err != nil {
+		return nil, err
+	}
+
+	uptime := time.Since(m.started)
+	summary := &Summary{
+		Uptime:         uptime.Round(time.Second).String(),
+		UptimeSeconds:  int64(uptime.Seconds()),
+		StatusClasses:  make(map[string]uint64),
+		Services:       make(map[string]*ServiceSummary),
+		MQTTMessages:   make(map[string]uint64),
+		EventPublishes: make(map[string]uint64),
+	}
+	service := func(name string) *ServiceSummary {
+		if s, exists := summary.Services[name]; exists {
+			return s
+		}
+		s := &ServiceSummary{}
+		summary.Services[name] = s
+		return s
+	}
+
+	var errors uint64
+	var latencySum float64
+	var latencyCount uint64
+	serviceLatency := make(map[string]float64)
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := labelMap(metric)
+			switch family.GetName() {
+			case m.name("requests_total"):
+				count := uint64(metric.GetCounter().GetValue())
+				summary.TotalRequests += count
+				summary.StatusClasses[labels["status"][:1]+"xx"] += count
+				s := service(labels["service"])
+				s.Requests += count
+				if labels["status"] >= "500" {
+					errors += count
+					s.Errors += count
+				}
+			case m.name("request_duration_seconds"):
+				h := metric.GetHistogram()
+				latencySum += h.GetSampleSum()
+				latencyCount += h.GetSampleCount()
+				serviceLatency[labels["service"]] += h.GetSampleSum()
+			case m.name("requests_in_flight"):
+				summary.ActiveRequests += int64(metric.GetGauge().GetValue())
+			case m.name("upstream_errors_total"):
+				service(labels["service"]).UpstreamErrors += uint64(metric.GetCounter().GetValue())
+			case m.name("mqtt_messages_total"):
+				summary.MQTTMessages[labels["direction"]+"_"+labels["result"]] += uint64(metric.GetCounter().GetValue())
+			case m.name("event_bus_publish_total"):
+				summary.EventPublishes[labels["result"]] += uint64(metric.GetCounter().GetValue())
+			}
+		}
+	}
+
+	if summary.TotalRequests > 0 {
+		summary.ErrorRate = float64(errors) / float64(summary.TotalRequests)
+	}
+	if latencyCount > 0 {
+		summary.AvgLatencyMs = latencySum / float64(latencyCount) * 1000
+	}
+	for name, s := range summary.Services {
+		if s.Requests > 0 {
+			s.AvgLatencyMs = serviceLatency[name] / float64(s.Requests) * 1000
+		}
+	}
+	return summary, nil
+}
+
+func labelMap(metric *dto.Metric) map[string]string {
+	labels := make(map[string]string, len(metric.GetLabel()))
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	return labels
+}
+
+// name returns the fully qualified name of a gateway metric
+func (m *Metrics) name(name string) string {
+	return prometheus.BuildFQName(m.namespace, m.subsystem, name)
+}
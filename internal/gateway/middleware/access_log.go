@@ -0,0 +1,323 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+const redacted = "[REDACTED]"
+
+// defaultRedactedQuery are the query parameters RequestLogger never logs
+var defaultRedactedQuery = map[string]bool{"api_key": true, "token": true, "access_token": true}
+
+type accessSampling struct {
+	prefix string
+	rate   float64
+}
+
+// AccessLogger writes one entry per request, separately from the
+// application log, as JSON or in the combined log format
+type AccessLogger struct {
+	format        string
+	writer        io.WriteCloser
+	mu            sync.Mutex
+	headers       []string
+	redactHeaders map[string]bool
+	redactQuery   map[string]bool
+	sampling      []accessSampling // longest prefix first
+}
+
+// AccessLatency splits the time spent on a request
+type AccessLatency struct {
+	TotalMs    float64 `json:"total_ms"`
+	AuthMs     float64 `json:"auth_ms,omitempty"`
+	UpstreamMs float64 `json:"upstream_ms,omitempty"`
+	GatewayMs  float64 `json:"gateway_ms"` // total minus auth and upstream
+}
+
+// AccessUpstream is the upstream that served a proxied request
+type AccessUpstream struct {
+	Service  string `json:"service"`
+	Instance string `json:"instance,omitempty"`
+	Attempts int    `json:"attempts,omitempty"`
+}
+
+// AccessEntry is the JSON schema of the access log
+type AccessEntry struct {
+	Time       time.Time         `json:"time"`
+	RequestID  string            `json:"request_id,omitempty"`
+	TraceID    string            `json:"trace_id,omitempty"`
+	ClientIP   string            `json:"client_ip"`
+	Method     string            `json:"method"`
+	Path       string            `json:"path"`
+	Query      string            `json:"query,omitempty"`
+	Protocol   string            `json:"protocol"`
+	Status     int               `json:"status"`
+	BytesIn    int64             `json:"bytes_in"`
+	BytesOut   int64             `json:"bytes_out"`
+	Route      string            `json:"route,omitempty"`
+	Upstream   *AccessUpstream   `json:"upstream,omitempty"`
+	AuthMethod string            `json:"auth_method,omitempty"`
+	UserID     string            `json:"user_id,omitempty"`
+	UserAgent  string            `json:"user_agent,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Latency    AccessLatency     `json:"latency"`
+	SampleRate float64           `json:"sample_rate,omitempty"` // set when the entry stands for 1/rate requests
+}
+
+// NewAccessLogger opens the access log described by cfg
+func NewAccessLogger(cfg config.AccessLogConfig) (*AccessLogger, error) {
+	format := strings.ToLower(cfg.Format)
+	switch format {
+	case "":
+		format = "json"
+	case "json", "combined":
+	default:
+		return nil, fmt.Errorf("unsupported access log format %q", cfg.Format)
+	}
+
+	a := &AccessLogger{
+		format:        format,
+		redactHeaders: make(map[string]bool, len(cfg.RedactHeaders)),
+		redactQuery:   make(map[string]bool, len(cfg.RedactQuery)),
+	}
+	for _, header := range cfg.Headers {
+		a.headers = append(a.headers, http.CanonicalHeaderKey(header))
+	}
+	for _, header := range cfg.RedactHeaders {
+		a.redactHeaders[http.CanonicalHeaderKey(header)] = true
+	}
+	for _, param := range cfg.RedactQuery {
+		a.redactQuery[strings.ToLower(param)] = true
+	}
+
+	seen := make(map[string]bool, len(cfg.Sampling))
+	for _, rule := range cfg.Sampling {
+		if !strings.HasPrefix(rule.PathPrefix, "/") {
+			return nil, fmt.Errorf("access log sampling path_prefix %q must start with /", rule.PathPrefix)
+		}
+		if seen[rule.PathPrefix] {
+			return nil, fmt.Errorf("duplicate access log sampling for %s", rule.PathPrefix)
+		}
+		seen[rule.PathPrefix] = true
+		if rule.Rate < 0 || rule.Rate > 1 {
+			return nil, fmt.Errorf("access log sampling rate for %s must be between 0 and 1", rule.PathPrefix)
+		}
+		a.sampling = append(a.sampling, accessSampling{prefix: rule.PathPrefix, rate: rule.Rate})
+	}
+	sort.SliceStable(a.sampling, func(i, j int) bool {
+		return len(a.sampling[i].prefix) > len(a.sampling[j].prefix)
+	})
+
+	writer, err := logger.NewWriter(cfg.Output, cfg.File, logger.Rotation{
+		MaxSizeMB:  cfg.Rotation.MaxSizeMB,
+		MaxBackups: cfg.Rotation.MaxBackups,
+		MaxAgeDays: cfg.Rotation.MaxAgeDays,
+		Interval:   cfg.Rotation.Interval,
+		Compress:   cfg.Rotation.Compress,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access log: %w", err)
+	}
+	a.writer = writer
+	return a, nil
+}
+
+// Close closes the access log file
+func (a *AccessLogger) Close() error {
+	return a.writer.Close()
+}
+
+// Middleware logs every request once it has been handled
+func (a *AccessLogger) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		path := c.Request.URL.Path
+		rawQuery := c.Request.URL.RawQuery
+
+		c.Next()
+
+		status := c.Writer.Status()
+		rate := a.sampleRate(path)
+		if status < http.StatusBadRequest && rate < 1 && rand.Float64() >= rate {
+			return
+		}
+
+		entry := a.entry(c, start, path, rawQuery)
+		if rate < 1 {
+			entry.SampleRate = rate
+		}
+
+		var line []byte
+		if a.format == "combined" {
+			line = a.combined(entry)
+		} else {
+			var err error
+			if line, err = json.Marshal(entry); err != nil {
+				return
+			}
+			line = append(line, '\n')
+		}
+
+		a.mu.Lock()
+		a.writer.Write(line)
+		a.mu.Unlock()
+	}
+}
+
+// sampleRate returns the share of successful requests to path that are logged
+func (a *AccessLogger) sampleRate(path string) float64 {
+	for _, rule := range a.sampling {
+		if strings.HasPrefix(path, rule.prefix) {
+			return rule.rate
+		}
+	}
+	return 1
+}
+
+func (a *AccessLogger) entry(c *gin.Context, start time.Time, path, rawQuery string) *AccessEntry {
+	total := time.Since(start)
+	authLatency := c.GetDuration("auth_latency")
+	upstreamLatency := c.GetDuration("upstream_latency")
+	gatewayLatency := total - authLatency - upstreamLatency
+	if gatewayLatency < 0 {
+		gatewayLatency = 0
+	}
+
+	entry := &AccessEntry{
+		Time:       start.UTC(),
+		RequestID:  c.GetString("request_id"),
+		TraceID:    c.GetString("trace_id"),
+		ClientIP:   c.ClientIP(),
+		Method:     c.Request.Method,
+		Path:       path,
+		Query:      redactQuery(rawQuery, a.redactQuery),
+		Protocol:   c.Request.Proto,
+		Status:     c.Writer.Status(),
+		BytesIn:    max(c.Request.ContentLength, 0),
+		BytesOut:   int64(max(c.Writer.Size(), 0)),
+		Route:      c.GetString("route"),
+		AuthMethod: c.GetString("auth_method"),
+		UserID:     c.GetString("user_id"),
+		UserAgent:  c.Request.UserAgent(),
+		Latency: AccessLatency{
+			TotalMs:    milliseconds(total),
+			AuthMs:     milliseconds(authLatency),
+			UpstreamMs: milliseconds(upstreamLatency),
+			GatewayMs:  milliseconds(gatewayLatency),
+		},
+	}
+	if entry.Route == "" {
+		entry.Route = c.FullPath()
+	}
+	if service := c.GetString("service"); service != "" {
+		entry.Upstream = &AccessUpstream{
+			Service:  service,
+			Instance: c.GetString("upstream_instance"),
+			Attempts: c.GetInt("upstream_attempts"),
+		}
+	}
+
+	for _, name := range a.headers {
+		value := c.Request.Header.Get(name)
+		if value == "" {
+			continue
+		}
+		if entry.Headers == nil {
+			entry.Headers = make(map[string]string, len(a.headers))
+		}
+		entry.Headers[name] = a.redactHeader(name, value)
+	}
+	return entry
+}
+
+// combined formats an entry in the combined log format followed by the
+// gateway fields as key=value pairs
+func (a *AccessLogger) combined(entry *AccessEntry) []byte {
+	target := entry.Path
+	if entry.Query != "" {
+		target += "?" + entry.Query
+	}
+	user := entry.UserID
+	if user == "" {
+		user = "-"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s - %s [%s] %q %d %d %q %q",
+		entry.ClientIP, user, entry.Time.Format("02/Jan/2006:15:04:05 -0700"),
+		entry.Method+" "+target+" "+entry.Protocol, entry.Status, entry.BytesOut,
+		dash(entry.Headers["Referer"]), dash(entry.UserAgent))
+	fmt.Fprintf(&b, " request_id=%s rt=%.3f auth_rt=%.3f upstream_rt=%.3f",
+		dash(entry.RequestID), entry.Latency.TotalMs/1000, entry.Latency.AuthMs/1000, entry.Latency.UpstreamMs/1000)
+	if entry.Upstream != nil {
+		fmt.Fprintf(&b, " service=%s upstream=%s attempts=%d",
+			entry.Upstream.Service, dash(entry.Upstream.Instance), entry.Upstream.Attempts)
+	}
+	if entry.TraceID != "" {
+		fmt.Fprintf(&b, " trace_id=%s", entry.TraceID)
+	}
+	if entry.SampleRate > 0 {
+		fmt.Fprintf(&b, " sample_rate=%s", strconv.FormatFloat(entry.SampleRate, 'g', -1, 64))
+	}
+	b.WriteByte('\n')
+	return []byte(b.String())
+}
+
+// redactHeader hides credentials; for Authorization the scheme is kept
+func (a *AccessLogger) redactHeader(name, value string) string {
+	if !a.redactHeaders[name] {
+		return value
+	}
+	if name == "Authorization" || name == "Proxy-Authorization" {
+		if scheme, _, found := strings.Cut(value, " "); found {
+			return scheme + " " + redacted
+		}
+	}
+	return redacted
+}
+
+// redactQuery replaces the values of sensitive query parameters, keeping the
+// order and encoding of the others
+func redactQuery(rawQuery string, params map[string]bool) string {
+	if rawQuery == "" || len(params) == 0 {
+		return rawQuery
+	}
+	parts := strings.Split(rawQuery, "&")
+	for i, part := range parts {
+		key, _, _ := strings.Cut(part, "=")
+		if unescaped, err := url.QueryUnescape(key); err == nil {
+			key = unescaped
+		}
+		if params[strings.ToLower(key)] {
+			name, _, _ := strings.Cut(part, "=")
+			parts[i] = name + "=" + redacted
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+func dash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
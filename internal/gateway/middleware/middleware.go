@@ -1,16 +1,15 @@
 package middleware
 
 import (
-	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"golang.org/x/time/rate"
 
 	"github.com/isa-cloud/isa_cloud/pkg/logger"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/auth"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/clients"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/registry"
 )
@@ -30,7 +29,7 @@ func RequestLogger(logger *logger.Logger) gin.HandlerFunc {
 		latency := end.Sub(start)
 
 		if raw != "" {
-			path = path + "?" + raw
+			path = path + "?" + redactQuery(raw, defaultRedactedQuery)
 		}
 
 		logger.Info("HTTP request",
@@ -45,6 +44,23 @@ func RequestLogger(logger *logger.Logger) gin.HandlerFunc {
 	}
 }
 
+// NormalizePath cleans the request path before routing, authorization and
+// rate limiting look at it, and rejects paths with empty segments
+func NormalizePath() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cleaned, err := auth.CleanPath(c.Request.URL.Path)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid request path"})
+			return
+		}
+		if cleaned != c.Request.URL.Path {
+			c.Request.URL.Path = cleaned
+			c.Request.URL.RawPath = ""
+		}
+		c.Next()
+	}
+}
+
 // RequestID returns a middleware that adds a unique request ID to each request
 func RequestID() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -53,29 +69,14 @@ func RequestID() gin.HandlerFunc {
 			requestID = uuid.New().String()
 		}
 
+		// Upstream services see the same ID as the client
+		c.Request.Header.Set("X-Request-ID", requestID)
 		c.Header("X-Request-ID", requestID)
 		c.Set("request_id", requestID)
 		c.Next()
 	}
 }
 
-// RateLimit returns a rate limiting middleware
-func RateLimit(rps, burst int) gin.HandlerFunc {
-	limiter := rate.NewLimiter(rate.Limit(rps), burst)
-
-	return func(c *gin.Context) {
-		if !limiter.Allow() {
-			c.JSON(http.StatusTooManyRequests, gin.H{
-				"error": "rate limit exceeded",
-				"message": fmt.Sprintf("rate limit: %d requests per second", rps),
-			})
-			c.Abort()
-			return
-		}
-		c.Next()
-	}
-}
-
 // AuthenticationWithRegistry returns an authentication middleware with service registry
 func AuthenticationWithRegistry(authClient clients.AuthClient, consul *registry.ConsulRegistry, logger *logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -3,25 +3,40 @@ package middleware
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/isa-cloud/isa_cloud/internal/gateway/audit"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/auth"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/clients"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/registry"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
 	"github.com/isa-cloud/isa_cloud/pkg/logger"
 )
 
+// authServiceClient calls the Auth and Authorization Services
+var authServiceClient = &http.Client{
+	Timeout:   5 * time.Second,
+	Transport: tracing.Transport(http.DefaultTransport, "auth_service"),
+}
+
 // AuthService response structs
 type TokenVerificationResponse struct {
-	Valid     bool   `json:"valid"`
-	Provider  string `json:"provider"`
-	UserID    string `json:"user_id"`
-	Email     string `json:"email"`
-	ExpiresAt string `json:"expires_at"`
-	Error     string `json:"error"`
+	Valid          bool     `json:"valid"`
+	Provider       string   `json:"provider"`
+	UserID         string   `json:"user_id"`
+	Email          string   `json:"email"`
+	OrganizationID string   `json:"organization_id"`
+	Scopes         []string `json:"scopes"`
+	ExpiresAt      string   `json:"expires_at"`
+	Error          string   `json:"error"`
 }
 
 type APIKeyVerificationResponse struct {
@@ -47,11 +62,121 @@ type AccessCheckResponse struct {
 	Metadata           map[string]interface{} `json:"metadata"`
 }
 
+// AuthOption configures optional behaviour of UnifiedAuthentication
+type AuthOption func(*authOptions)
+
+// authOptions holds the optional collaborators of UnifiedAuthentication
+type authOptions struct {
+	jwtVerifier *auth.JWTVerifier
+	cache       *auth.VerificationCache
+	services    *auth.ServiceAuthenticator
+	identities  *auth.CertificateIdentities
+	authzPolicy *auth.AuthorizationPolicy
+	rules       *auth.AuthorizationRules
+	devAgents   []string // development bypass; nil when disabled
+	auditor     *audit.Auditor
+	auditServices bool // audit successful internal service authentication
+}
+
+// authFailureKey holds the authFailure of a request whose credentials were
+// not accepted, for the audit record of the rejection
+const authFailureKey = "auth_failure"
+
+type authFailure struct {
+	credential string // jwt or api_key
+	reason     string
+	invalid    bool // the credential was rejected, not just unverifiable
+}
+
+var (
+	errAccessDenied             = errors.New("insufficient permissions")
+	errAuthorizationUnavailable = errors.New("authorization service unavailable")
+)
+
+// WithJWTVerifier verifies JWTs from trusted issuers locally; opaque tokens
+// and other issuers are still verified by the Auth Service
+func WithJWTVerifier(verifier *auth.JWTVerifier) AuthOption {
+	return func(o *authOptions) {
+		o.jwtVerifier = verifier
+	}
+}
+
+// WithVerificationCache caches token, API key and authorization results
+func WithVerificationCache(cache *auth.VerificationCache) AuthOption {
+	return func(o *authOptions) {
+		o.cache = cache
+	}
+}
+
+// WithServiceAuthenticator accepts HMAC-signed requests from internal
+// services. Without it, service credentials are not accepted at all
+func WithServiceAuthenticator(authenticator *auth.ServiceAuthenticator) AuthOption {
+	return func(o *authOptions) {
+		o.services = authenticator
+	}
+}
+
+// WithCertificateIdentities trusts verified client certificates that map to
+// an internal service
+func WithCertificateIdentities(identities *auth.CertificateIdentities) AuthOption {
+	return func(o *authOptions) {
+		o.identities = identities
+	}
+}
+
+// WithAuthorizationPolicy sets what happens when the Authorization Service
+// cannot answer. Without it permission checks fail open
+func WithAuthorizationPolicy(policy *auth.AuthorizationPolicy) AuthOption {
+	return func(o *authOptions) {
+		o.authzPolicy = policy
+	}
+}
+
+// WithAuthorizationRules sets the rules mapping requests to permission
+// checks. Without it the built-in rules are used
+func WithAuthorizationRules(rules *auth.AuthorizationRules) AuthOption {
+	return func(o *authOptions) {
+		o.rules = rules
+	}
+}
+
+// WithAuditor records authentication failures, authorization denials and
+// fallbacks and, when internalServices is set, every internal service
+// authentication
+func WithAuditor(auditor *audit.Auditor, internalServices bool) AuthOption {
+	return func(o *authOptions) {
+		o.auditor = auditor
+		o.auditServices = internalServices
+	}
+}
+
+// WithDevelopmentBypass lets loopback clients whose User-Agent contains one
+// of userAgents in as an internal service without credentials. It is only for
+// the development auth profile
+func WithDevelopmentBypass(userAgents []string) AuthOption {
+	return func(o *authOptions) {
+		o.devAgents = make([]string, 0, len(userAgents))
+		for _, userAgent := range userAgents {
+			if userAgent = strings.ToLower(strings.TrimSpace(userAgent)); userAgent != "" {
+				o.devAgents = append(o.devAgents, userAgent)
+			}
+		}
+	}
+}
+
 // UnifiedAuthentication provides a unified authentication middleware that:
 // 1. Routes external requests through Auth Service (8202)
 // 2. Maintains compatibility with service-specific auth (Agent, MCP)
 // 3. Handles internal service-to-service communication
-func UnifiedAuthentication(authClient clients.AuthClient, consul *registry.ConsulRegistry, logger *logger.Logger) gin.HandlerFunc {
+func UnifiedAuthentication(authClient clients.AuthClient, consul *registry.ConsulRegistry, logger *logger.Logger, opts ...AuthOption) gin.HandlerFunc {
+	options := &authOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.rules == nil {
+		options.rules = auth.DefaultAuthorizationRules()
+	}
+
 	return func(c *gin.Context) {
 		// Skip authentication for health checks and public endpoints
 		if isPublicEndpoint(c.Request.URL.Path) {
@@ -60,16 +185,21 @@ func UnifiedAuthentication(authClient clients.AuthClient, consul *registry.Consu
 		}
 
 		// Check for internal service authentication first
-		if authenticated := handleInternalServiceAuth(c, consul, logger); authenticated {
+		if handled := handleInternalServiceAuth(c, consul, options, logger); handled {
 			return
 		}
 
 		// Handle external authentication via Auth Service
-		if authenticated := handleExternalAuth(c, authClient, logger); authenticated {
+		if authenticated := handleExternalAuth(c, authClient, options, logger); authenticated {
+			return
+		}
+		// Rejected by the permission check, which has already responded
+		if c.IsAborted() {
 			return
 		}
 
 		// Authentication failed
+		auditAuthenticationFailure(c, options)
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error": "authentication required",
 			"message": "valid JWT token or API key required",
@@ -94,35 +224,84 @@ func isPublicEndpoint(path string) bool {
 	return false
 }
 
-// handleInternalServiceAuth handles service-to-service authentication
-func handleInternalServiceAuth(c *gin.Context, consul *registry.ConsulRegistry, logger *logger.Logger) bool {
-	// Method 1: Explicit internal service header with Consul validation
-	serviceName := c.GetHeader("X-Service-Name")
-	serviceSecret := c.GetHeader("X-Service-Secret")
-	
-	if serviceName != "" && serviceSecret != "" && consul != nil {
-		// Validate service is registered in Consul
-		if isValidInternalService(serviceName, consul, logger) {
-			// In production, validate serviceSecret against a shared secret
-			// For now, accept any registered service
+// handleInternalServiceAuth handles service-to-service authentication. It
+// returns true when it has handled the request, either by authenticating it
+// or by rejecting invalid service credentials
+func handleInternalServiceAuth(c *gin.Context, consul *registry.ConsulRegistry, options *authOptions, logger *logger.Logger) bool {
+	// Method 1: verified client certificate mapped to a service
+	if serviceName, ok := options.identities.Resolve(c.Request.TLS); ok {
+		logger.Debug("Internal service authenticated by client certificate",
+			"service", serviceName,
+			"path", c.Request.URL.Path,
+		)
+		c.Set("user_id", "service-"+serviceName)
+		c.Set("organization_id", "internal")
+		c.Set("is_internal", true)
+		c.Set("service_name", serviceName)
+		c.Set("auth_method", "mtls")
+		auditServiceAuthentication(c, options, serviceName)
+		c.Next()
+		return true
+	}
+
+	// Method 2: HMAC-signed request from a service with configured keys
+	if options.services != nil {
+		identity, err := options.services.Authenticate(c.Request)
+		switch {
+		case err == nil:
+			if options.services.RequireRegistered() && !isValidInternalService(c.Request.Context(), identity.Name, consul, logger) {
+				err = fmt.Errorf("%w: service %q is not registered", auth.ErrInvalidServiceCredentials, identity.Name)
+				break
+			}
 			logger.Debug("Internal service authenticated",
-				"service", serviceName,
+				"service", identity.Name,
+				"key_id", identity.KeyID,
 				"path", c.Request.URL.Path,
 			)
-			c.Set("user_id", "service-"+serviceName)
+			c.Set("user_id", "service-"+identity.Name)
 			c.Set("organization_id", "internal")
 			c.Set("is_internal", true)
-			c.Set("service_name", serviceName)
+			c.Set("service_name", identity.Name)
+			c.Set("auth_method", "service_hmac")
+			auditServiceAuthentication(c, options, identity.Name)
 			c.Next()
 			return true
+		case errors.Is(err, auth.ErrNoServiceCredentials):
+			err = nil
+		}
+		if err != nil {
+			logger.Warn("Internal service authentication failed",
+				"service", c.GetHeader("X-Service-Name"),
+				"ip", c.ClientIP(),
+				"path", c.Request.URL.Path,
+				"error", err,
+			)
+			if options.auditor != nil {
+				record := audit.FromRequest(c, audit.ActionServiceAuthentication)
+				record.Decision = audit.DecisionDeny
+				record.Reason = err.Error()
+				record.Alert = true
+				record.Details = map[string]interface{}{"service": c.GetHeader("X-Service-Name")}
+				options.auditor.Record(c.Request.Context(), record)
+			}
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "authentication failed",
+				"message": "invalid service credentials",
+			})
+			c.Abort()
+			return true
 		}
 	}
 
-	// Method 2: Development mode - localhost with service user agents
+	// Method 3: Development profile only - loopback clients with a tool user agent.
+	// ClientIP only honours forwarding headers from trusted proxies
+	if options.devAgents == nil {
+		return false
+	}
 	clientIP := c.ClientIP()
 	if isLocalhost(clientIP) {
 		userAgent := c.GetHeader("User-Agent")
-		if isServiceUserAgent(userAgent) {
+		if isServiceUserAgent(userAgent, options.devAgents) {
 			logger.Debug("Local development service authenticated",
 				"ip", clientIP,
 				"user_agent", userAgent,
@@ -131,6 +310,8 @@ func handleInternalServiceAuth(c *gin.Context, consul *registry.ConsulRegistry,
 			c.Set("user_id", "local-dev-service")
 			c.Set("organization_id", "local")
 			c.Set("is_internal", true)
+			c.Set("auth_method", "dev_bypass")
+			auditServiceAuthentication(c, options, "")
 			c.Next()
 			return true
 		}
@@ -140,22 +321,22 @@ func handleInternalServiceAuth(c *gin.Context, consul *registry.ConsulRegistry,
 }
 
 // handleExternalAuth handles external user authentication via Auth Service
-func handleExternalAuth(c *gin.Context, authClient clients.AuthClient, logger *logger.Logger) bool {
+func handleExternalAuth(c *gin.Context, authClient clients.AuthClient, options *authOptions, logger *logger.Logger) bool {
 	// Try JWT token authentication first
-	if authenticated := handleJWTAuth(c, authClient, logger); authenticated {
+	if authenticated := handleJWTAuth(c, authClient, options, logger); authenticated {
 		return true
 	}
 
 	// Try API key authentication
-	if authenticated := handleAPIKeyAuth(c, authClient, logger); authenticated {
+	if authenticated := handleAPIKeyAuth(c, authClient, options, logger); authenticated {
 		return true
 	}
 
 	return false
 }
 
-// handleJWTAuth validates JWT tokens via Auth Service
-func handleJWTAuth(c *gin.Context, authClient clients.AuthClient, logger *logger.Logger) bool {
+// handleJWTAuth validates JWT tokens locally or via Auth Service
+func handleJWTAuth(c *gin.Context, authClient clients.AuthClient, options *authOptions, logger *logger.Logger) bool {
 	authHeader := c.GetHeader("Authorization")
 	if authHeader == "" {
 		return false
@@ -172,29 +353,20 @@ func handleJWTAuth(c *gin.Context, authClient clients.AuthClient, logger *logger
 		return false
 	}
 
-	// Call Auth Service to verify token
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	// Keep the trace of the request but not its cancellation
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), 5*time.Second)
 	defer cancel()
 
-	// Create verification request
-	payload := map[string]interface{}{
-		"token": token,
-	}
-
-	response, err := makeAuthServiceRequest(ctx, "http://localhost:8202/api/v1/auth/verify-token", payload)
+	tokenResp, err := verifyToken(ctx, token, options, logger)
 	if err != nil {
 		logger.Error("Auth service request failed", "error", err)
-		return false
-	}
-
-	var tokenResp TokenVerificationResponse
-	if err := json.Unmarshal(response, &tokenResp); err != nil {
-		logger.Error("Failed to parse token verification response", "error", err)
+		c.Set(authFailureKey, authFailure{credential: "jwt", reason: "token verification unavailable"})
 		return false
 	}
 
 	if !tokenResp.Valid {
 		logger.Debug("Token validation failed", "error", tokenResp.Error)
+		c.Set(authFailureKey, authFailure{credential: "jwt", reason: "invalid token: " + tokenResp.Error, invalid: true})
 		return false
 	}
 
@@ -204,9 +376,24 @@ func handleJWTAuth(c *gin.Context, authClient clients.AuthClient, logger *logger
 	c.Set("provider", tokenResp.Provider)
 	c.Set("is_internal", false)
 	c.Set("auth_method", "jwt")
+	if tokenResp.OrganizationID != "" {
+		c.Set("organization_id", tokenResp.OrganizationID)
+	}
+	if len(tokenResp.Scopes) > 0 {
+		c.Set("scopes", tokenResp.Scopes)
+	}
 
 	// Check resource-specific permissions if needed
-	if hasResourceAccess := checkResourcePermissions(c, tokenResp.UserID, logger); !hasResourceAccess {
+	if err := checkResourcePermissions(c, tokenResp.UserID, options, logger); err != nil {
+		if errors.Is(err, errAuthorizationUnavailable) {
+			c.Header("Retry-After", "5")
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"error": "authorization unavailable",
+				"message": "authorization is temporarily unavailable, please retry",
+			})
+			c.Abort()
+			return false
+		}
 		c.JSON(http.StatusForbidden, gin.H{
 			"error": "insufficient permissions",
 			"message": "user does not have permission to access this resource",
@@ -224,8 +411,73 @@ func handleJWTAuth(c *gin.Context, authClient clients.AuthClient, logger *logger
 	return true
 }
 
+// verifyToken returns a cached verification result or verifies the token and
+// caches the result
+func verifyToken(ctx context.Context, token string, options *authOptions, logger *logger.Logger) (_ *TokenVerificationResponse, err error) {
+	ctx, span := tracing.Start(ctx, "auth.verify_token")
+	defer func() { tracing.End(span, err) }()
+
+	if options.cache != nil {
+		var cached TokenVerificationResponse
+		if _, found := options.cache.Token(ctx, token, &cached); found {
+			span.SetAttributes(attribute.Bool("auth.cached", true), attribute.Bool("auth.valid", cached.Valid))
+			return &cached, nil
+		}
+	}
+
+	tokenResp, err := verifyTokenUncached(ctx, token, options, logger)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.Bool("auth.cached", false), attribute.Bool("auth.valid", tokenResp.Valid))
+	if options.cache != nil {
+		options.cache.StoreToken(ctx, token, tokenResp.UserID, tokenResp.Valid, parseExpiry(tokenResp.ExpiresAt), tokenResp)
+	}
+	return tokenResp, nil
+}
+
+// verifyTokenUncached verifies a bearer token locally when the token comes
+// from a trusted issuer and through the Auth Service otherwise
+func verifyTokenUncached(ctx context.Context, token string, options *authOptions, logger *logger.Logger) (*TokenVerificationResponse, error) {
+	if options.jwtVerifier != nil {
+		claims, err := options.jwtVerifier.Verify(ctx, token)
+		switch {
+		case err == nil:
+			return &TokenVerificationResponse{
+				Valid:          true,
+				Provider:       claims.Issuer,
+				UserID:         claims.Subject,
+				Email:          claims.Email,
+				OrganizationID: claims.OrganizationID,
+				Scopes:         claims.Scopes,
+				ExpiresAt:      claims.ExpiresAt.UTC().Format(time.RFC3339),
+			}, nil
+		case errors.Is(err, auth.ErrNotJWT), errors.Is(err, auth.ErrUnknownIssuer):
+			logger.Debug("Token not verifiable locally, using Auth Service", "reason", err)
+		default:
+			return &TokenVerificationResponse{Valid: false, Error: err.Error()}, nil
+		}
+	}
+
+	// Call Auth Service to verify token
+	payload := map[string]interface{}{
+		"token": token,
+	}
+
+	response, err := makeAuthServiceRequest(ctx, "http://localhost:8202/api/v1/auth/verify-token", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenResp TokenVerificationResponse
+	if err := json.Unmarshal(response, &tokenResp); err != nil {
+		return nil, fmt.Errorf("failed to parse token verification response: %w", err)
+	}
+	return &tokenResp, nil
+}
+
 // handleAPIKeyAuth validates API keys via Auth Service
-func handleAPIKeyAuth(c *gin.Context, authClient clients.AuthClient, logger *logger.Logger) bool {
+func handleAPIKeyAuth(c *gin.Context, authClient clients.AuthClient, options *authOptions, logger *logger.Logger) bool {
 	// Check multiple sources for API key
 	apiKey := c.GetHeader("X-API-Key")
 	if apiKey == "" {
@@ -242,27 +494,19 @@ func handleAPIKeyAuth(c *gin.Context, authClient clients.AuthClient, logger *log
 	}
 
 	// Call Auth Service to verify API key
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), 5*time.Second)
 	defer cancel()
 
-	payload := map[string]interface{}{
-		"api_key": apiKey,
-	}
-
-	response, err := makeAuthServiceRequest(ctx, "http://localhost:8202/api/v1/auth/verify-api-key", payload)
+	keyResp, err := verifyAPIKey(ctx, apiKey, options)
 	if err != nil {
 		logger.Error("Auth service API key verification failed", "error", err)
-		return false
-	}
-
-	var keyResp APIKeyVerificationResponse
-	if err := json.Unmarshal(response, &keyResp); err != nil {
-		logger.Error("Failed to parse API key verification response", "error", err)
+		c.Set(authFailureKey, authFailure{credential: "api_key", reason: "api key verification unavailable"})
 		return false
 	}
 
 	if !keyResp.Valid {
 		logger.Debug("API key validation failed", "error", keyResp.Error)
+		c.Set(authFailureKey, authFailure{credential: "api_key", reason: "invalid api key: " + keyResp.Error, invalid: true})
 		return false
 	}
 
@@ -284,14 +528,65 @@ func handleAPIKeyAuth(c *gin.Context, authClient clients.AuthClient, logger *log
 	return true
 }
 
+// verifyAPIKey returns a cached verification result or verifies the API key
+// via Auth Service. Invalid keys are cached briefly as well
+func verifyAPIKey(ctx context.Context, apiKey string, options *authOptions) (_ *APIKeyVerificationResponse, err error) {
+	ctx, span := tracing.Start(ctx, "auth.verify_api_key")
+	defer func() { tracing.End(span, err) }()
+
+	if options.cache != nil {
+		var cached APIKeyVerificationResponse
+		if _, found := options.cache.APIKey(ctx, apiKey, &cached); found {
+			span.SetAttributes(attribute.Bool("auth.cached", true), attribute.Bool("auth.valid", cached.Valid))
+			return &cached, nil
+		}
+	}
+	span.SetAttributes(attribute.Bool("auth.cached", false))
+
+	payload := map[string]interface{}{
+		"api_key": apiKey,
+	}
+
+	response, err := makeAuthServiceRequest(ctx, "http://localhost:8202/api/v1/auth/verify-api-key", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyResp APIKeyVerificationResponse
+	if err := json.Unmarshal(response, &keyResp); err != nil {
+		return nil, fmt.Errorf("failed to parse API key verification response: %w", err)
+	}
+	span.SetAttributes(attribute.Bool("auth.valid", keyResp.Valid))
+
+	if options.cache != nil {
+		subject := ""
+		if keyResp.Valid {
+			subject = "api-key-" + keyResp.KeyID
+		}
+		options.cache.StoreAPIKey(ctx, apiKey, keyResp.KeyID, subject, keyResp.Valid, &keyResp)
+	}
+	return &keyResp, nil
+}
+
 // Helper functions
 
-func isValidInternalService(serviceName string, consul *registry.ConsulRegistry, logger *logger.Logger) bool {
+// parseExpiry parses an RFC 3339 expiry; unknown formats yield the zero time
+func parseExpiry(value string) time.Time {
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return expiresAt
+}
+
+func isValidInternalService(ctx context.Context, serviceName string, consul *registry.ConsulRegistry, logger *logger.Logger) bool {
 	if consul == nil {
 		return false
 	}
 
+	_, span := tracing.Start(ctx, "consul.list_services", attribute.String("gateway.service", serviceName))
 	services, err := consul.ListServices()
+	tracing.End(span, err)
 	if err != nil {
 		logger.Error("Failed to list Consul services", "error", err)
 		return false
@@ -302,18 +597,11 @@ func isValidInternalService(serviceName string, consul *registry.ConsulRegistry,
 }
 
 func isLocalhost(ip string) bool {
-	return ip == "127.0.0.1" || ip == "::1" || strings.HasPrefix(ip, "localhost")
+	parsed := net.ParseIP(ip)
+	return parsed != nil && parsed.IsLoopback()
 }
 
-func isServiceUserAgent(userAgent string) bool {
-	serviceUserAgents := []string{
-		"python-httpx",
-		"axios",
-		"node-fetch",
-		"go-resty",
-		"curl",
-	}
-
+func isServiceUserAgent(userAgent string, serviceUserAgents []string) bool {
 	userAgent = strings.ToLower(userAgent)
 	for _, serviceUA := range serviceUserAgents {
 		if strings.Contains(userAgent, serviceUA) {
@@ -336,11 +624,7 @@ func makeAuthServiceRequest(ctx context.Context, url string, payload map[string]
 
 	req.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{
-		Timeout: 5 * time.Second,
-	}
-
-	resp, err := client.Do(req)
+	resp, err := authServiceClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -365,48 +649,75 @@ func makeAuthServiceRequest(ctx context.Context, url string, payload map[string]
 	return responseBody, nil
 }
 
-// checkResourcePermissions validates user permissions for specific resources via Authorization Service
-func checkResourcePermissions(c *gin.Context, userID string, logger *logger.Logger) bool {
-	// Determine resource type and name from the request path
-	resourceType, resourceName, requiredLevel := getResourceInfoFromPath(c.Request.URL.Path)
-	
-	// Skip permission check for public resources or if resource type is not recognized
-	if resourceType == "" || requiredLevel == "" {
-		return true
+// checkResourcePermissions validates user permissions for specific resources via Authorization Service.
+// It returns nil when access is granted, errAccessDenied when it is refused and
+// errAuthorizationUnavailable when the route's failure policy rejects the request
+func checkResourcePermissions(c *gin.Context, userID string, options *authOptions, logger *logger.Logger) error {
+	// Determine resource type and name from the authorization rules;
+	// requests no rule matches need no permission check
+	match, found := options.rules.Match(c.Request.Method, c.Request.URL.Path)
+	if !found {
+		return nil
 	}
+	resourceType, resourceName, requiredLevel := match.ResourceType, match.ResourceName, match.RequiredLevel
 
 	// Call Authorization Service to check access
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), 3*time.Second)
 	defer cancel()
+	ctx, span := tracing.Start(ctx, "auth.check_access",
+		attribute.String("auth.rule", match.Rule),
+		attribute.String("auth.resource_type", resourceType),
+		attribute.String("auth.resource_name", resourceName),
+		attribute.String("auth.required_level", requiredLevel),
+	)
+	defer span.End()
 
-	payload := map[string]interface{}{
-		"user_id": userID,
-		"resource_type": resourceType,
-		"resource_name": resourceName,
-		"required_access_level": requiredLevel,
-	}
+	var accessResp AccessCheckResponse
+	cached := options.cache != nil &&
+		options.cache.Decision(ctx, userID, resourceType, resourceName, requiredLevel, &accessResp)
+	span.SetAttributes(attribute.Bool("auth.cached", cached))
+
+	if !cached {
+		payload := map[string]interface{}{
+			"user_id": userID,
+			"resource_type": resourceType,
+			"resource_name": resourceName,
+			"required_access_level": requiredLevel,
+		}
 
-	response, err := makeAuthServiceRequest(ctx, "http://localhost:8203/api/v1/authorization/check-access", payload)
-	if err != nil {
-		logger.Error("Authorization service request failed", "error", err, "user_id", userID)
-		// In case of service failure, allow access for now (fail-open policy)
-		return true
-	}
+		response, err := makeAuthServiceRequest(ctx, "http://localhost:8203/api/v1/authorization/check-access", payload)
+		if err != nil {
+			logger.Error("Authorization service request failed", "error", err, "user_id", userID)
+			return authorizationFallback(ctx, c, userID, resourceType, resourceName, requiredLevel, options, logger)
+		}
 
-	var accessResp AccessCheckResponse
-	if err := json.Unmarshal(response, &accessResp); err != nil {
-		logger.Error("Failed to parse access check response", "error", err)
-		return true
+		if err := json.Unmarshal(response, &accessResp); err != nil {
+			logger.Error("Failed to parse access check response", "error", err)
+			return authorizationFallback(ctx, c, userID, resourceType, resourceName, requiredLevel, options, logger)
+		}
+
+		if options.cache != nil {
+			options.cache.StoreDecision(ctx, userID, resourceType, resourceName, requiredLevel,
+				accessResp.HasAccess, parseExpiry(accessResp.ExpiresAt), options.authzPolicy.StaleGrace(), &accessResp)
+		}
 	}
 
+	span.SetAttributes(attribute.Bool("auth.has_access", accessResp.HasAccess))
 	if !accessResp.HasAccess {
 		logger.Debug("Access denied by authorization service",
 			"user_id", userID,
 			"resource_type", resourceType,
 			"resource_name", resourceName,
+			"rule", match.Rule,
 			"reason", accessResp.Reason,
+			"cached", cached,
 		)
-		return false
+		reason := accessResp.Reason
+		if reason == "" {
+			reason = "denied by authorization service"
+		}
+		auditAuthorization(c, options, resourceType, resourceName, requiredLevel, audit.DecisionDeny, reason)
+		return errAccessDenied
 	}
 
 	// Store permission info in context for downstream services
@@ -417,74 +728,125 @@ func checkResourcePermissions(c *gin.Context, userID string, logger *logger.Logg
 	logger.Debug("Access granted by authorization service",
 		"user_id", userID,
 		"resource_type", resourceType,
+		"rule", match.Rule,
 		"access_level", accessResp.UserAccessLevel,
 		"permission_source", accessResp.PermissionSource,
+		"cached", cached,
 	)
 
-	return true
+	return nil
 }
 
-// getResourceInfoFromPath extracts resource information from the request path
-func getResourceInfoFromPath(path string) (resourceType, resourceName, requiredLevel string) {
-	// Define resource mappings based on API paths using Authorization Service's expected types
-	if strings.HasPrefix(path, "/api/v1/blockchain/") {
-		return "api_endpoint", "blockchain_" + extractBlockchainResource(path), "read_only"
-	}
-	
-	if strings.HasPrefix(path, "/api/v1/agents/") {
-		return "api_endpoint", "agent_chat", getAgentAccessLevel(path)
-	}
-	
-	if strings.HasPrefix(path, "/api/v1/mcp/") {
-		return "mcp_tool", extractMCPResource(path), getMCPAccessLevel(path)
-	}
-	
-	if strings.HasPrefix(path, "/api/v1/gateway/") {
-		return "api_endpoint", "gateway_management", "read_only"
+// authorizationFallback decides a permission check the Authorization Service
+// could not answer: a stale cached decision within the grace window is used
+// first, otherwise the route's failure policy applies. Every fallback is
+// counted and logged
+func authorizationFallback(ctx context.Context, c *gin.Context, userID, resourceType, resourceName, requiredLevel string, options *authOptions, logger *logger.Logger) error {
+	policy := options.authzPolicy
+	path := c.Request.URL.Path
+
+	if options.cache != nil && policy.StaleGrace() > 0 {
+		var accessResp AccessCheckResponse
+		if age, found := options.cache.StaleDecision(ctx, userID, resourceType, resourceName, requiredLevel, &accessResp); found {
+			policy.RecordFallback(auth.FallbackStale)
+			logger.Warn("Using stale authorization decision",
+				"user_id", userID,
+				"path", path,
+				"resource_type", resourceType,
+				"resource_name", resourceName,
+				"has_access", accessResp.HasAccess,
+				"age", age,
+			)
+			if !accessResp.HasAccess {
+				auditAuthorization(c, options, resourceType, resourceName, requiredLevel, audit.DecisionDeny, "stale decision while authorization is unavailable")
+				return errAccessDenied
+			}
+			c.Set("access_level", accessResp.UserAccessLevel)
+			c.Set("permission_source", accessResp.PermissionSource)
+			c.Set("subscription_tier", accessResp.SubscriptionTier)
+			c.Set("authorization_stale", true)
+			return nil
+		}
 	}
 
-	// Unknown resource, skip permission check
-	return "", "", ""
-}
-
-// Helper functions to extract resource details from specific API paths
-
-func extractBlockchainResource(path string) string {
-	if strings.Contains(path, "/balance/") {
-		return "balance_check"
-	}
-	if strings.Contains(path, "/transaction") {
-		return "transaction"
-	}
-	if strings.Contains(path, "/status") {
-		return "status"
+	switch failurePolicy := policy.PolicyFor(path); failurePolicy {
+	case auth.FailClosed:
+		policy.RecordFallback(auth.FallbackClosed)
+		logger.Warn("Authorization unavailable, rejecting request (fail_closed)",
+			"user_id", userID, "path", path, "required_level", requiredLevel)
+		auditAuthorization(c, options, resourceType, resourceName, requiredLevel, audit.DecisionDeny, "authorization unavailable (fail_closed)")
+		return errAuthorizationUnavailable
+
+	case auth.FailDegraded:
+		if !policy.AllowsDegraded(requiredLevel) {
+			policy.RecordFallback(auth.FallbackDenied)
+			logger.Warn("Authorization unavailable, request exceeds degraded scope",
+				"user_id", userID, "path", path, "required_level", requiredLevel,
+				"degraded_level", policy.DegradedLevel())
+			auditAuthorization(c, options, resourceType, resourceName, requiredLevel, audit.DecisionDeny, "authorization unavailable, exceeds degraded scope")
+			return errAuthorizationUnavailable
+		}
+		policy.RecordFallback(auth.FallbackDegraded)
+		logger.Warn("Authorization unavailable, allowing request with degraded scope",
+			"user_id", userID, "path", path, "required_level", requiredLevel,
+			"degraded_level", policy.DegradedLevel())
+		auditAuthorization(c, options, resourceType, resourceName, requiredLevel, audit.DecisionAllow, "authorization unavailable (degraded)")
+		c.Set("access_level", policy.DegradedLevel())
+		c.Set("authorization_degraded", true)
+		return nil
+
+	default:
+		policy.RecordFallback(auth.FallbackOpen)
+		logger.Warn("Authorization unavailable, allowing request (fail_open)",
+			"user_id", userID, "path", path, "required_level", requiredLevel)
+		auditAuthorization(c, options, resourceType, resourceName, requiredLevel, audit.DecisionAllow, "authorization unavailable (fail_open)")
+		return nil
 	}
-	return "blockchain_general"
 }
 
-func getAgentAccessLevel(path string) string {
-	if strings.Contains(path, "/api/chat") {
-		return "read_write" // Chat requires read_write
-	}
-	return "read_only"
+// auditAuthenticationFailure records a request rejected for missing or
+// invalid credentials. Rejected credentials raise a security alert
+func auditAuthenticationFailure(c *gin.Context, options *authOptions) {
+	if options.auditor == nil {
+		return
+	}
+	record := audit.FromRequest(c, audit.ActionAuthentication)
+	record.Decision = audit.DecisionDeny
+	record.Reason = "no credentials"
+	if failure, ok := c.Value(authFailureKey).(authFailure); ok {
+		record.Reason = failure.reason
+		record.Alert = failure.invalid
+		record.Details = map[string]interface{}{"credential": failure.credential}
+	}
+	options.auditor.Record(c.Request.Context(), record)
 }
 
-func extractMCPResource(path string) string {
-	if strings.Contains(path, "/search") {
-		return "search"
-	}
-	if strings.Contains(path, "/tools/call") {
-		return "tool_execution"
+// auditServiceAuthentication records an internal service let in, when
+// enabled. service is empty for the development bypass
+func auditServiceAuthentication(c *gin.Context, options *authOptions, service string) {
+	if options.auditor == nil || !options.auditServices {
+		return
 	}
-	if strings.Contains(path, "/prompts/get") {
-		return "prompt_access"
+	record := audit.FromRequest(c, audit.ActionServiceAuthentication)
+	record.Decision = audit.DecisionAllow
+	if service != "" {
+		record.Details = map[string]interface{}{"service": service}
 	}
-	return "mcp_general"
+	options.auditor.Record(c.Request.Context(), record)
 }
 
-func getMCPAccessLevel(path string) string {
-	if strings.Contains(path, "/tools/call") {
-		return "read_write" // Tool execution requires read_write
+// auditAuthorization records a permission decision on a resource
+func auditAuthorization(c *gin.Context, options *authOptions, resourceType, resourceName, requiredLevel, decision, reason string) {
+	if options.auditor == nil {
+		return
 	}
-	return "read_only"
-}
\ No newline at end of file
+	record := audit.FromRequest(c, audit.ActionAuthorization)
+	record.Resource = resourceType + ":" + resourceName
+	record.Decision = decision
+	record.Reason = reason
+	record.Details = map[string]interface{}{
+		"required_level": requiredLevel,
+		"request":        c.Request.Method + " " + c.Request.URL.Path,
+	}
+	options.auditor.Record(c.Request.Context(), record)
+}
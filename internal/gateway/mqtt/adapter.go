@@ -2,6 +2,7 @@ package mqtt
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,7 +11,11 @@ import (
 	"time"
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
 	"github.com/isa-cloud/isa_cloud/pkg/logger"
 )
 
@@ -24,10 +29,13 @@ type Adapter struct {
 	httpClient   *http.Client
 	deviceConfig *config.DeviceManagementConfig
 	isConnected  bool
+
+	// observe is told about every message, e.g. for metrics
+	observe func(direction, result string)
 }
 
-// MessageHandler processes MQTT messages
-type MessageHandler func(topic string, payload []byte) error
+// MessageHandler processes MQTT messages. ctx carries the span of the message
+type MessageHandler func(ctx context.Context, topic string, payload []byte) error
 
 // DeviceMessage represents a message from/to IoT device
 type DeviceMessage struct {
@@ -44,7 +52,7 @@ func NewAdapter(cfg *config.MQTTConfig, deviceCfg *config.DeviceManagementConfig
 		deviceConfig: deviceCfg,
 		logger:       logger,
 		handlers:     make(map[string]MessageHandler),
-		httpClient:   &http.Client{Timeout: 30 * time.Second},
+		httpClient:   &http.Client{Timeout: 30 * time.Second, Transport: tracing.Transport(http.DefaultTransport, "mqtt_forward")},
 		isConnected:  false,
 	}
 
@@ -121,8 +129,44 @@ func (a *Adapter) Subscribe(topic string, handler MessageHandler) error {
 	return nil
 }
 
+// SetObserver sets a function told about every received ("in") and published
+// ("out") message. result is ok, error or, for received messages, unhandled
+func (a *Adapter) SetObserver(observe func(direction, result string)) {
+	a.observe = observe
+}
+
+func (a *Adapter) observeMessage(direction, result string) {
+	if a.observe != nil {
+		a.observe(direction, result)
+	}
+}
+
 // Publish message to MQTT topic
 func (a *Adapter) Publish(topic string, payload interface{}) error {
+	return a.PublishContext(context.Background(), topic, payload)
+}
+
+// PublishContext publishes a message in a span of the trace in ctx. MQTT 3.1.1
+// has no user properties, so the trace context is not sent to devices
+func (a *Adapter) PublishContext(ctx context.Context, topic string, payload interface{}) error {
+	_, span := tracing.Tracer().Start(ctx, "mqtt publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "mqtt"),
+			attribute.String("messaging.destination.name", topic),
+		),
+	)
+	err := a.publish(topic, payload)
+	tracing.End(span, err)
+	if err != nil {
+		a.observeMessage("out", "error")
+	} else {
+		a.observeMessage("out", "ok")
+	}
+	return err
+}
+
+func (a *Adapter) publish(topic string, payload interface{}) error {
 	var data []byte
 	var err error
 
@@ -165,7 +209,7 @@ func (a *Adapter) setupDefaultHandlers() {
 	a.handlers[a.config.Topics.DeviceRegistration] = a.handleDeviceRegistration
 }
 
-// handleMessage processes incoming MQTT messages
+// handleMessage processes incoming MQTT messages. Each message starts a trace
 func (a *Adapter) handleMessage(topic string, payload []byte) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
@@ -173,21 +217,35 @@ func (a *Adapter) handleMessage(topic string, payload []byte) {
 	// Find matching handler
 	for pattern, handler := range a.handlers {
 		if a.matchTopic(pattern, topic) {
-			if err := handler(topic, payload); err != nil {
+			ctx, span := tracing.Tracer().Start(context.Background(), "mqtt process "+pattern,
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithAttributes(
+					attribute.String("messaging.system", "mqtt"),
+					attribute.String("messaging.destination.name", topic),
+					attribute.Int("messaging.message.body.size", len(payload)),
+				),
+			)
+			err := handler(ctx, topic, payload)
+			tracing.End(span, err)
+			if err != nil {
 				a.logger.Error("Error handling MQTT message", 
 					"topic", topic, 
 					"error", err,
 				)
+				a.observeMessage("in", "error")
+			} else {
+				a.observeMessage("in", "ok")
 			}
 			return
 		}
 	}
 
 	a.logger.Debug("No handler found for MQTT topic", "topic", topic)
+	a.observeMessage("in", "unhandled")
 }
 
 // handleTelemetry processes device telemetry data
-func (a *Adapter) handleTelemetry(topic string, payload []byte) error {
+func (a *Adapter) handleTelemetry(ctx context.Context, topic string, payload []byte) error {
 	deviceID := a.extractDeviceID(topic)
 
 	var telemetry map[string]interface{}
@@ -201,13 +259,13 @@ func (a *Adapter) handleTelemetry(topic string, payload []byte) error {
 	}
 
 	// Forward to telemetry service via HTTP
-	return a.forwardToService("telemetry_service", 
+	return a.forwardToService(ctx, "telemetry_service", 
 		fmt.Sprintf("/api/v1/devices/%s/telemetry", deviceID), 
 		telemetry)
 }
 
 // handleDeviceStatus processes device status updates
-func (a *Adapter) handleDeviceStatus(topic string, payload []byte) error {
+func (a *Adapter) handleDeviceStatus(ctx context.Context, topic string, payload []byte) error {
 	deviceID := a.extractDeviceID(topic)
 
 	var status map[string]interface{}
@@ -221,13 +279,13 @@ func (a *Adapter) handleDeviceStatus(topic string, payload []byte) error {
 	}
 
 	// Forward to device management service
-	return a.forwardToService("device_service", 
+	return a.forwardToService(ctx, "device_service", 
 		fmt.Sprintf("/api/v1/devices/%s/status", deviceID), 
 		status)
 }
 
 // handleCommandResponse processes command responses from devices
-func (a *Adapter) handleCommandResponse(topic string, payload []byte) error {
+func (a *Adapter) handleCommandResponse(ctx context.Context, topic string, payload []byte) error {
 	deviceID := a.extractDeviceID(topic)
 
 	var response map[string]interface{}
@@ -246,7 +304,7 @@ func (a *Adapter) handleCommandResponse(topic string, payload []byte) error {
 }
 
 // handleDeviceAuth handles device authentication requests
-func (a *Adapter) handleDeviceAuth(topic string, payload []byte) error {
+func (a *Adapter) handleDeviceAuth(ctx context.Context, topic string, payload []byte) error {
 	deviceID := a.extractDeviceID(topic)
 
 	var authData map[string]interface{}
@@ -258,25 +316,25 @@ func (a *Adapter) handleDeviceAuth(topic string, payload []byte) error {
 	authResult, err := a.authenticateDevice(deviceID, authData)
 	if err != nil {
 		// Send auth failure response
-		return a.Publish(fmt.Sprintf("devices/%s/auth/response", deviceID), map[string]interface{}{
+		return a.PublishContext(ctx, fmt.Sprintf("devices/%s/auth/response", deviceID), map[string]interface{}{
 			"success": false,
 			"error":   err.Error(),
 		})
 	}
 
 	// Send auth success response
-	return a.Publish(fmt.Sprintf("devices/%s/auth/response", deviceID), authResult)
+	return a.PublishContext(ctx, fmt.Sprintf("devices/%s/auth/response", deviceID), authResult)
 }
 
 // handleDeviceRegistration handles new device registration
-func (a *Adapter) handleDeviceRegistration(topic string, payload []byte) error {
+func (a *Adapter) handleDeviceRegistration(ctx context.Context, topic string, payload []byte) error {
 	var regData map[string]interface{}
 	if err := json.Unmarshal(payload, &regData); err != nil {
 		return err
 	}
 
 	// Forward to device management service for registration
-	result, err := a.registerDevice(regData)
+	result, err := a.registerDevice(ctx, regData)
 	if err != nil {
 		a.logger.Error("Device registration failed", "error", err)
 		return err
@@ -284,7 +342,7 @@ func (a *Adapter) handleDeviceRegistration(topic string, payload []byte) error {
 
 	// Send registration result back to device if device_id is available
 	if deviceID, ok := result["device_id"].(string); ok {
-		return a.Publish(fmt.Sprintf("devices/%s/register/response", deviceID), result)
+		return a.PublishContext(ctx, fmt.Sprintf("devices/%s/register/response", deviceID), result)
 	}
 
 	a.logger.Info("Device registration completed", "result", result)
@@ -292,9 +350,9 @@ func (a *Adapter) handleDeviceRegistration(topic string, payload []byte) error {
 }
 
 // SendCommandToDevice sends a command to a device via MQTT
-func (a *Adapter) SendCommandToDevice(deviceID string, command map[string]interface{}) error {
+func (a *Adapter) SendCommandToDevice(ctx context.Context, deviceID string, command map[string]interface{}) error {
 	topic := fmt.Sprintf("devices/%s/commands", deviceID)
-	return a.Publish(topic, command)
+	return a.PublishContext(ctx, topic, command)
 }
 
 // Connection event handlers
@@ -360,7 +418,7 @@ func (a *Adapter) extractDeviceID(topic string) string {
 }
 
 // forwardToService forwards a message to an HTTP service
-func (a *Adapter) forwardToService(serviceName, endpoint string, data interface{}) error {
+func (a *Adapter) forwardToService(ctx context.Context, serviceName, endpoint string, data interface{}) error {
 	if !a.deviceConfig.Enabled {
 		a.logger.Debug("Device management disabled, skipping service forward")
 		return nil
@@ -388,7 +446,7 @@ func (a *Adapter) forwardToService(serviceName, endpoint string, data interface{
 		return fmt.Errorf("failed to marshal data: %v", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %v", err)
 	}
@@ -428,9 +486,9 @@ func (a *Adapter) authenticateDevice(deviceID string, authData map[string]interf
 }
 
 // registerDevice registers a new device via device management service
-func (a *Adapter) registerDevice(regData map[string]interface{}) (map[string]interface{}, error) {
+func (a *Adapter) registerDevice(ctx context.Context, regData map[string]interface{}) (map[string]interface{}, error) {
 	// Forward to device management service
-	err := a.forwardToService("device_service", "/api/v1/devices", regData)
+	err := a.forwardToService(ctx, "device_service", "/api/v1/devices", regData)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,349 @@
+package proxy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+)
+
+// BreakerState is the state of a circuit breaker
+type BreakerState string
+
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half_open"
+)
+
+// breakerBuckets is the number of buckets the rolling window is split into
+const breakerBuckets = 10
+
+// BreakerStatus is a point-in-time view of a circuit breaker
+type BreakerStatus struct {
+	State     BreakerState `json:"state"`
+	Requests  int          `json:"requests"`
+	Failures  int          `json:"failures"`
+	ErrorRate float64      `json:"error_rate"`
+	OpenedAt  *time.Time   `json:"opened_at,omitempty"`
+}
+
+// breakerBucket holds call outcomes for one slice of the rolling window
+type breakerBucket struct {
+	start    time.Time
+	requests int
+	failures int
+}
+
+// circuitBreaker trips when the failure rate over a rolling window exceeds
+// the threshold, rejects calls while open and probes with a limited number of
+// calls once the open period has elapsed
+type circuitBreaker struct {
+	mu       sync.Mutex
+	settings config.CircuitBreakerSettings
+	state    BreakerState
+	openedAt time.Time
+	buckets  [breakerBuckets]breakerBucket
+
+	halfOpenInFlight  int
+	halfOpenSuccesses int
+}
+
+func newCircuitBreaker(settings config.CircuitBreakerSettings) *circuitBreaker {
+	return &circuitBreaker{
+		settings: settings,
+		state:    BreakerClosed,
+	}
+}
+
+// ejected reports whether the breaker currently rejects calls, without
+// consuming a half-open probe slot
+func (b *circuitBreaker) ejected() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		return time.Since(b.openedAt) < b.settings.OpenDuration
+	case BreakerHalfOpen:
+		return b.halfOpenInFlight >= b.settings.HalfOpenRequests
+	}
+	return false
+}
+
+// allow reports whether a call may proceed; in half-open state it reserves a probe slot
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.settings.OpenDuration {
+			return false
+		}
+		b.state = BreakerHalfOpen
+		b.halfOpenInFlight = 0
+		b.halfOpenSuccesses = 0
+		fallthrough
+	case BreakerHalfOpen:
+		if b.halfOpenInFlight >= b.settings.HalfOpenRequests {
+			return false
+		}
+		b.halfOpenInFlight++
+	}
+	return true
+}
+
+// record registers the outcome of a call admitted by allow
+func (b *circuitBreaker) record(success bool, latency time.Duration) {
+	if success && b.settings.LatencyThreshold > 0 && latency > b.settings.LatencyThreshold {
+		success = false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	switch b.state {
+	case BreakerHalfOpen:
+		if b.halfOpenInFlight > 0 {
+			b.halfOpenInFlight--
+		}
+		if !success {
+			b.trip(now)
+			return
+		}
+		b.halfOpenSuccesses++
+		if b.halfOpenSuccesses >= b.settings.HalfOpenRequests {
+			b.state = BreakerClosed
+			b.buckets = [breakerBuckets]breakerBucket{}
+		}
+		return
+	case BreakerOpen:
+		// Late result of a call admitted before the breaker opened
+		return
+	}
+
+	bucket := b.bucket(now)
+	bucket.requests++
+	if !success {
+		bucket.failures++
+	}
+
+	requests, failures := b.totals(now)
+	if requests >= b.settings.MinRequests && float64(failures)/float64(requests) >= b.settings.ErrorRateThreshold {
+		b.trip(now)
+	}
+}
+
+// release frees a probe slot reserved by allow for a call whose outcome says
+// nothing about the upstream, e.g. a client that failed its own handshake
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerHalfOpen && b.halfOpenInFlight > 0 {
+		b.halfOpenInFlight--
+	}
+}
+
+// status returns the current breaker status
+func (b *circuitBreaker) status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	requests, failures := b.totals(time.Now())
+	status := BreakerStatus{
+		State:    b.state,
+		Requests: requests,
+		Failures: failures,
+	}
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.settings.OpenDuration {
+		// Will move to half-open on the next call
+		status.State = BreakerHalfOpen
+	}
+	if requests > 0 {
+		status.ErrorRate = float64(failures) / float64(requests)
+	}
+	if b.state != BreakerClosed {
+		openedAt := b.openedAt
+		status.OpenedAt = &openedAt
+	}
+	return status
+}
+
+func (b *circuitBreaker) trip(now time.Time) {
+	b.state = BreakerOpen
+	b.openedAt = now
+	b.halfOpenInFlight = 0
+	b.halfOpenSuccesses = 0
+}
+
+// bucket returns the bucket for now, resetting it if it belongs to an earlier cycle
+func (b *circuitBreaker) bucket(now time.Time) *breakerBucket {
+	width := b.settings.Window / breakerBuckets
+	start := now.Truncate(width)
+	bucket := &b.buckets[(start.UnixNano()/int64(width))%breakerBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = breakerBucket{start: start}
+	}
+	return bucket
+}
+
+// totals sums the buckets that fall within the rolling window
+func (b *circuitBreaker) totals(now time.Time) (requests, failures int) {
+	for _, bucket := range b.buckets {
+		if now.Sub(bucket.start) < b.settings.Window {
+			requests += bucket.requests
+			failures += bucket.failures
+		}
+	}
+	return requests, failures
+}
+
+// breakerSet holds the service-level and instance-level breakers
+type breakerSet struct {
+	mu       sync.RWMutex
+	config   config.CircuitBreakerConfig
+	services map[string]*circuitBreaker
+	// instances is keyed by service, then instance ID
+	instances map[string]map[string]*circuitBreaker
+}
+
+func newBreakerSet(cfg config.CircuitBreakerConfig) *breakerSet {
+	return &breakerSet{
+		config:    cfg,
+		services:  make(map[string]*circuitBreaker),
+		instances: make(map[string]map[string]*circuitBreaker),
+	}
+}
+
+// settingsFor merges per-service overrides over the global settings
+func (s *breakerSet) settingsFor(service string) config.CircuitBreakerSettings {
+	settings := s.config.CircuitBreakerSettings
+	if override, exists := s.config.Services[service]; exists {
+		if override.ErrorRateThreshold > 0 {
+			settings.ErrorRateThreshold = override.ErrorRateThreshold
+		}
+		if override.LatencyThreshold > 0 {
+			settings.LatencyThreshold = override.LatencyThreshold
+		}
+		if override.MinRequests > 0 {
+			settings.MinRequests = override.MinRequests
+		}
+		if override.Window > 0 {
+			settings.Window = override.Window
+		}
+		if override.OpenDuration > 0 {
+			settings.OpenDuration = override.OpenDuration
+		}
+		if override.HalfOpenRequests > 0 {
+			settings.HalfOpenRequests = override.HalfOpenRequests
+		}
+	}
+
+	if settings.ErrorRateThreshold <= 0 {
+		settings.ErrorRateThreshold = 0.5
+	}
+	if settings.MinRequests <= 0 {
+		settings.MinRequests = 20
+	}
+	if settings.Window < breakerBuckets*time.Millisecond {
+		settings.Window = 30 * time.Second
+	}
+	if settings.OpenDuration <= 0 {
+		settings.OpenDuration = 30 * time.Second
+	}
+	if settings.HalfOpenRequests <= 0 {
+		settings.HalfOpenRequests = 1
+	}
+	return settings
+}
+
+// service returns the service-level breaker, or nil when breakers are disabled
+func (s *breakerSet) service(name string) *circuitBreaker {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	s.mu.RLock()
+	breaker, exists := s.services[name]
+	s.mu.RUnlock()
+	if exists {
+		return breaker
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if breaker, exists = s.services[name]; !exists {
+		breaker = newCircuitBreaker(s.settingsFor(name))
+		s.services[name] = breaker
+	}
+	return breaker
+}
+
+// instance returns the breaker of one upstream instance, or nil when breakers are disabled
+func (s *breakerSet) instance(service, instanceID string) *circuitBreaker {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	s.mu.RLock()
+	breaker, exists := s.instances[service][instanceID]
+	s.mu.RUnlock()
+	if exists {
+		return breaker
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	instances, exists := s.instances[service]
+	if !exists {
+		instances = make(map[string]*circuitBreaker)
+		s.instances[service] = instances
+	}
+	if breaker, exists = instances[instanceID]; !exists {
+		breaker = newCircuitBreaker(s.settingsFor(service))
+		instances[instanceID] = breaker
+	}
+	return breaker
+}
+
+// isEjected reports whether an instance is currently ejected by its breaker
+func (s *breakerSet) isEjected(service, instanceID string) bool {
+	if breaker := s.instance(service, instanceID); breaker != nil {
+		return breaker.ejected()
+	}
+	return false
+}
+
+// forget drops the breakers of instances that are no longer in the catalog
+func (s *breakerSet) forget(service string, present map[string]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id := range s.instances[service] {
+		if !present[id] {
+			delete(s.instances[service], id)
+		}
+	}
+}
+
+// statuses returns the status of every breaker, grouped by service
+func (s *breakerSet) statuses() map[string]interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make(map[string]interface{}, len(s.services))
+	for name, breaker := range s.services {
+		instances := make(map[string]BreakerStatus, len(s.instances[name]))
+		for id, instanceBreaker := range s.instances[name] {
+			instances[id] = instanceBreaker.status()
+		}
+		result[name] = map[string]interface{}{
+			"service":   breaker.status(),
+			"instances": instances,
+		}
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package proxy
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/pkg/identity"
+)
+
+// newIdentitySigner creates the signer for forwarded identities, or nil when
+// identity forwarding is disabled
+func newIdentitySigner(cfg config.IdentityForwardingConfig) (*identity.Signer, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	secret := cfg.Secret
+	if cfg.SecretFile != "" {
+		data, err := os.ReadFile(cfg.SecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read identity secret: %w", err)
+		}
+		secret = strings.TrimSpace(string(data))
+	}
+	return identity.NewSigner(cfg.KeyID, []byte(secret), cfg.TTL)
+}
+
+// ForwardIdentity removes any identity header sent by the client and, for
+// authenticated requests, adds one signed by the gateway. The SSE and reverse
+// proxies copy it upstream with the other request headers, the gRPC
+// forwarder as metadata
+func (dp *DynamicProxy) ForwardIdentity(c *gin.Context) {
+	c.Request.Header.Del(dp.identityHeader)
+	if dp.identity == nil {
+		return
+	}
+	userID := c.GetString("user_id")
+	if userID == "" {
+		return
+	}
+
+	claims := identity.Claims{
+		OrganizationID:   c.GetString("organization_id"),
+		Email:            c.GetString("email"),
+		AuthMethod:       c.GetString("auth_method"),
+		Scopes:           c.GetStringSlice("scopes"),
+		Permissions:      c.GetStringSlice("permissions"),
+		AccessLevel:      c.GetString("access_level"),
+		SubscriptionTier: c.GetString("subscription_tier"),
+		Service:          c.GetString("service_name"),
+		Internal:         c.GetBool("is_internal"),
+	}
+	claims.Subject = userID
+	claims.ID = c.GetString("request_id")
+
+	value, err := dp.identity.Sign(claims)
+	if err != nil {
+		dp.logger.Error("Failed to sign forwarded identity", "user_id", userID, "error", err)
+		return
+	}
+	c.Request.Header.Set(dp.identityHeader, value)
+}
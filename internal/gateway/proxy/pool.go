@@ -0,0 +1,180 @@
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tlsutil"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+// staticUpstreamID identifies the statically configured upstream of a service in the pool
+const staticUpstreamID = "static"
+
+// pooledUpstream is the reusable proxy and transport of one upstream instance
+type pooledUpstream struct {
+	baseURL   string
+	transport *http.Transport
+	proxy     *httputil.ReverseProxy
+	client    *http.Client
+}
+
+// upstreamPool caches reverse proxies and transports per service instance so
+// connections to backends are reused across requests
+type upstreamPool struct {
+	mu       sync.RWMutex
+	settings config.TransportConfig
+	logger   *logger.Logger
+	tls      *tlsutil.ClientTLS // nil unless backends are reached over TLS
+	newProxy func(service string, target *url.URL) *httputil.ReverseProxy
+	// entries is keyed by service, then instance ID
+	entries map[string]map[string]*pooledUpstream
+}
+
+func newUpstreamPool(settings config.TransportConfig, clientTLS *tlsutil.ClientTLS, logger *logger.Logger, newProxy func(string, *url.URL) *httputil.ReverseProxy) *upstreamPool {
+	return &upstreamPool{
+		settings: settings,
+		logger:   logger,
+		tls:      clientTLS,
+		newProxy: newProxy,
+		entries:  make(map[string]map[string]*pooledUpstream),
+	}
+}
+
+// get returns the pooled upstream of an instance, creating it on first use.
+// An instance whose address changed gets a fresh entry
+func (p *upstreamPool) get(service, instanceID, baseURL string) (*pooledUpstream, error) {
+	p.mu.RLock()
+	entry, exists := p.entries[service][instanceID]
+	p.mu.RUnlock()
+	if exists && entry.baseURL == baseURL {
+		return entry, nil
+	}
+
+	target, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream URL %s: %w", baseURL, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	instances, exists := p.entries[service]
+	if !exists {
+		instances = make(map[string]*pooledUpstream)
+		p.entries[service] = instances
+	}
+	if entry, exists = instances[instanceID]; exists {
+		if entry.baseURL == baseURL {
+			return entry, nil
+		}
+		entry.transport.CloseIdleConnections()
+	}
+
+	transport := p.newTransport()
+	traced := tracing.Transport(transport, service)
+	proxy := p.newProxy(service, target)
+	proxy.Transport = traced
+
+	entry = &pooledUpstream{
+		baseURL:   baseURL,
+		transport: transport,
+		proxy:     proxy,
+		client:    &http.Client{Transport: traced},
+	}
+	instances[instanceID] = entry
+
+	p.logger.Debug("Created pooled upstream", "service", service, "instance", instanceID, "target", baseURL)
+	return entry, nil
+}
+
+// evict drops the upstreams of instances that are no longer in the catalog
+func (p *upstreamPool) evict(service string, present map[string]bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id, entry := range p.entries[service] {
+		if id == staticUpstreamID || present[id] {
+			continue
+		}
+		entry.transport.CloseIdleConnections()
+		delete(p.entries[service], id)
+		p.logger.Info("Evicted upstream that left the catalog", "service", service, "instance", id)
+	}
+}
+
+// close releases the idle connections of every pooled upstream
+func (p *upstreamPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, instances := range p.entries {
+		for _, entry := range instances {
+			entry.transport.CloseIdleConnections()
+		}
+	}
+	p.entries = make(map[string]map[string]*pooledUpstream)
+}
+
+// newTransport builds an upstream transport from the pool settings
+func (p *upstreamPool) newTransport() *http.Transport {
+	s := p.settings
+
+	dialer := &net.Dialer{
+		Timeout:   s.DialTimeout,
+		KeepAlive: s.KeepAlive,
+	}
+	if dialer.Timeout <= 0 {
+		dialer.Timeout = 10 * time.Second
+	}
+	if dialer.KeepAlive <= 0 {
+		dialer.KeepAlive = 30 * time.Second
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          s.MaxIdleConns,
+		MaxIdleConnsPerHost:   s.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       s.MaxConnsPerHost,
+		IdleConnTimeout:       s.IdleConnTimeout,
+		TLSHandshakeTimeout:   s.TLSHandshakeTimeout,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     s.HTTP2,
+	}
+	if transport.MaxIdleConnsPerHost <= 0 {
+		transport.MaxIdleConnsPerHost = 32
+	}
+	if transport.IdleConnTimeout <= 0 {
+		transport.IdleConnTimeout = 90 * time.Second
+	}
+	if transport.TLSHandshakeTimeout <= 0 {
+		transport.TLSHandshakeTimeout = 10 * time.Second
+	}
+
+	// Complete TLS handshakes with the current client certificate and CA bundle
+	if p.tls != nil {
+		protocols := []string{"http/1.1"}
+		if s.HTTP2 {
+			protocols = []string{"h2", "http/1.1"}
+		}
+		transport.DialTLSContext = p.tls.DialContext(dialer, transport.TLSHandshakeTimeout, protocols...)
+	}
+
+	// Prior-knowledge HTTP/2 over cleartext for backends that support it
+	if s.H2C {
+		protocols := new(http.Protocols)
+		protocols.SetHTTP1(false)
+		protocols.SetUnencryptedHTTP2(true)
+		transport.Protocols = protocols
+	}
+
+	return transport
+}
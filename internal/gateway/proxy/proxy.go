@@ -1,15 +1,25 @@
 package proxy
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/isa-cloud/isa_cloud/internal/config"
 	"github.com/isa-cloud/isa_cloud/internal/gateway/registry"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tlsutil"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
+	"github.com/isa-cloud/isa_cloud/pkg/identity"
 	"github.com/isa-cloud/isa_cloud/pkg/logger"
 )
 
@@ -20,16 +30,143 @@ type DynamicProxy struct {
 	proxies  map[string]*httputil.ReverseProxy
 	services map[string]*config.ServiceEndpoint
 	registry *registry.ConsulRegistry
+
+	// Retry policies per service, created on first use
+	retryMu         sync.Mutex
+	retryPolicies   map[string]*retryPolicy
+	defaultEndpoint *config.ServiceEndpoint
+
+	// Circuit breakers per service and per instance
+	breakers *breakerSet
+
+	// Compiled route table and the authentication run for routes that require it
+	routes        *routeTable
+	authenticator gin.HandlerFunc
+	rateLimiter   gin.HandlerFunc
+
+	// Pooled proxies and transports per instance, evicted as instances
+	// leave the catalog
+	pool    *upstreamPool
+	watchMu sync.Mutex
+	watched map[string]bool
+
+	// Upstream URL scheme; https when backends are reached over TLS
+	scheme    string
+	clientTLS *tlsutil.ClientTLS
+	stopTLS   context.CancelFunc
+
+	// Signed identity forwarded to backends, nil when disabled. The header
+	// is stripped from client requests either way
+	identity       *identity.Signer
+	identityHeader string
+
+	// WebSocket relay, nil when WebSocket proxying is disabled
+	websocket *WebSocketProxy
+
+	// Buffered SSE events for stream resumption, nil when disabled
+	replay *replayStore
+
+	// SSE stream counters per service
+	streamMu     sync.Mutex
+	streamTotals map[string]*StreamTotals
+
+	// Hooks for metrics; unset hooks are skipped
+	observer Observer
+}
+
+// Observer receives proxy events, e.g. to export metrics. Every hook is optional
+type Observer struct {
+	// RequestStarted is called once a request is routed; the returned
+	// function is called when it completes
+	RequestStarted func(service, route string) func()
+
+	// UpstreamError is called for every failed upstream attempt. reason is
+	// timeout, connection or the 5xx status class
+	UpstreamError func(service, reason string)
+
+	// StreamFinished is called with the counters of every SSE stream
+	StreamFinished func(service string, stats StreamStats)
 }
 
-// NewDynamicProxy creates a new dynamic proxy
-func NewDynamicProxy(cfg *config.Config, logger *logger.Logger, consulRegistry *registry.ConsulRegistry) *DynamicProxy {
+// StreamTotals aggregates the SSE streams proxied to one service
+type StreamTotals struct {
+	Streams      int64 `json:"streams"`
+	Completed    int64 `json:"completed"`
+	Disconnected int64 `json:"disconnected"`
+	Events       int64 `json:"events"`
+	Replayed     int64 `json:"replayed"`
+	Bytes        int64 `json:"bytes"`
+}
+
+// NewDynamicProxy creates a new dynamic proxy, compiling the configured route table
+func NewDynamicProxy(cfg *config.Config, logger *logger.Logger, consulRegistry *registry.ConsulRegistry) (*DynamicProxy, error) {
+	routeConfigs := cfg.Routes
+	if len(routeConfigs) == 0 {
+		logger.Info("No routes configured, using default /api/v1/{service} routing")
+		routeConfigs = defaultRoutes()
+	}
+	routes, err := compileRoutes(routeConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route table: %w", err)
+	}
+
 	dp := &DynamicProxy{
-		config:   cfg,
-		logger:   logger,
-		proxies:  make(map[string]*httputil.ReverseProxy),
-		services: make(map[string]*config.ServiceEndpoint),
-		registry: consulRegistry,
+		config:        cfg,
+		logger:        logger,
+		proxies:       make(map[string]*httputil.ReverseProxy),
+		services:      make(map[string]*config.ServiceEndpoint),
+		registry:      consulRegistry,
+		retryPolicies: make(map[string]*retryPolicy),
+		breakers:      newBreakerSet(cfg.Proxy.CircuitBreaker),
+		routes:        routes,
+		defaultEndpoint: &config.ServiceEndpoint{
+			Timeout:       30 * time.Second,
+			StreamTimeout: 30 * time.Minute,
+		},
+		watched:      make(map[string]bool),
+		streamTotals: make(map[string]*StreamTotals),
+	}
+	dp.scheme = "http"
+	dp.stopTLS = func() {}
+	var clientTLS *tlsutil.ClientTLS
+	if upstreamTLS := cfg.Proxy.Transport.TLS; upstreamTLS.Enabled {
+		var reloader *tlsutil.Reloader
+		if upstreamTLS.CAFile != "" || upstreamTLS.CertFile != "" {
+			reloader, err = tlsutil.NewReloader(upstreamTLS.CertFile, upstreamTLS.KeyFile, upstreamTLS.CAFile, logger)
+			if err != nil {
+				return nil, fmt.Errorf("invalid upstream TLS configuration: %w", err)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			dp.stopTLS = cancel
+			go reloader.Watch(ctx, upstreamTLS.ReloadInterval)
+		}
+		if upstreamTLS.InsecureSkipVerify {
+			logger.Warn("Upstream TLS certificate verification is disabled")
+		}
+		clientTLS = tlsutil.NewClientTLS(upstreamTLS, reloader)
+		dp.scheme = "https"
+		dp.clientTLS = clientTLS
+		logger.Info("Connecting to backends over TLS", "client_certificate", upstreamTLS.CertFile != "")
+	}
+	dp.identity, err = newIdentitySigner(cfg.Security.IdentityForwarding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid identity forwarding configuration: %w", err)
+	}
+	dp.identityHeader = cfg.Security.IdentityForwarding.Header
+	if dp.identityHeader == "" {
+		dp.identityHeader = identity.Header
+	}
+	dp.pool = newUpstreamPool(cfg.Proxy.Transport, clientTLS, logger, dp.newReverseProxy)
+	if cfg.Proxy.SSE.ReplayEvents > 0 {
+		dp.replay = newReplayStore(cfg.Proxy.SSE.ReplayEvents, cfg.Proxy.SSE.ReplayMaxBytes, cfg.Proxy.SSE.MaxReplayStreams, cfg.Proxy.SSE.ReplayTTL)
+	}
+	if cfg.Proxy.WebSocket.Enabled {
+		dp.websocket = NewWebSocketProxy(cfg.Proxy.WebSocket, cfg.Security.CORS.AllowOrigins, logger)
+		if clientTLS != nil {
+			timeout := dp.websocket.config.HandshakeTimeout
+			dialer := &net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}
+			dp.websocket.dialer.NetDialTLSContext = clientTLS.DialContext(dialer, timeout, "http/1.1")
+		}
 	}
 
 	// Initialize service mappings
@@ -42,20 +179,20 @@ func NewDynamicProxy(cfg *config.Config, logger *logger.Logger, consulRegistry *
 
 	// Create reverse proxies for each service
 	for name, svc := range dp.services {
-		targetURL := fmt.Sprintf("http://%s:%d", svc.Host, svc.HTTPPort)
-		target, err := url.Parse(targetURL)
+		targetURL := dp.staticURL(svc)
+		upstream, err := dp.pool.get(name, staticUpstreamID, targetURL)
 		if err != nil {
 			logger.Error("Failed to parse service URL", "service", name, "url", targetURL, "error", err)
 			continue
 		}
 
-		proxy := httputil.NewSingleHostReverseProxy(target)
-		
-		// Customize the proxy to add logging and error handling
+		proxy := upstream.proxy
+
+		// Customize the proxy to add logging
 		originalDirector := proxy.Director
 		proxy.Director = func(req *http.Request) {
 			originalDirector(req)
-			logger.Debug("Proxying request", 
+			logger.Debug("Proxying request",
 				"service", name,
 				"method", req.Method,
 				"path", req.URL.Path,
@@ -63,139 +200,738 @@ func NewDynamicProxy(cfg *config.Config, logger *logger.Logger, consulRegistry *
 			)
 		}
 
-		// Remove CORS headers from downstream services to avoid duplicates
-		proxy.ModifyResponse = func(resp *http.Response) error {
-			// Remove CORS headers that will be set by the gateway
-			resp.Header.Del("Access-Control-Allow-Origin")
-			resp.Header.Del("Access-Control-Allow-Methods")
-			resp.Header.Del("Access-Control-Allow-Headers")
-			resp.Header.Del("Access-Control-Allow-Credentials")
-			resp.Header.Del("Access-Control-Max-Age")
-			resp.Header.Del("Access-Control-Expose-Headers")
+		dp.proxies[name] = proxy
+	}
+
+	logger.Info("Route table compiled", "routes", len(routes.routes))
+	return dp, nil
+}
+
+// SetAuthenticator sets the middleware run for routes marked auth_required.
+// It must abort the context when authentication fails
+func (dp *DynamicProxy) SetAuthenticator(authenticator gin.HandlerFunc) {
+	dp.authenticator = authenticator
+}
+
+// SetObserver sets the hooks told about routed requests, upstream failures
+// and finished streams
+func (dp *DynamicProxy) SetObserver(observer Observer) {
+	dp.observer = observer
+}
+
+// observeUpstream reports a failed upstream attempt to the observer
+func (dp *DynamicProxy) observeUpstream(service string, status int, err error) {
+	if dp.observer.UpstreamError == nil {
+		return
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		dp.observer.UpstreamError(service, "timeout")
+	case err != nil:
+		dp.observer.UpstreamError(service, "connection")
+	case status >= http.StatusInternalServerError:
+		dp.observer.UpstreamError(service, "5xx")
+	}
+}
+
+// noteAttempt records an upstream attempt on the request for the access log:
+// the instance that served it, the number of attempts and the time spent
+// waiting on upstreams
+func noteAttempt(c *gin.Context, instance string, latency time.Duration) {
+	c.Set("upstream_instance", instance)
+	c.Set("upstream_attempts", c.GetInt("upstream_attempts")+1)
+	c.Set("upstream_latency", c.GetDuration("upstream_latency")+latency)
+}
+
+// ClientTLS returns the TLS settings for backend connections, or nil when
+// backends are reached in plaintext
+func (dp *DynamicProxy) ClientTLS() *tlsutil.ClientTLS {
+	return dp.clientTLS
+}
+
+// SetRateLimiter sets the middleware run after authentication to limit
+// callers. It must abort the context when a request is rejected
+func (dp *DynamicProxy) SetRateLimiter(rateLimiter gin.HandlerFunc) {
+	dp.rateLimiter = rateLimiter
+}
+
+// newReverseProxy creates a reverse proxy that cooperates with the retry loop:
+// failures of non-final attempts are recorded instead of written to the client
+func (dp *DynamicProxy) newReverseProxy(service string, target *url.URL) *httputil.ReverseProxy {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+
+	// Remove CORS headers from downstream services to avoid duplicates
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		// Remove CORS headers that will be set by the gateway
+		resp.Header.Del("Access-Control-Allow-Origin")
+		resp.Header.Del("Access-Control-Allow-Methods")
+		resp.Header.Del("Access-Control-Allow-Headers")
+		resp.Header.Del("Access-Control-Allow-Credentials")
+		resp.Header.Del("Access-Control-Max-Age")
+		resp.Header.Del("Access-Control-Expose-Headers")
+
+		dp.observeUpstream(service, resp.StatusCode, nil)
+
+		state := attemptStateFrom(resp.Request.Context())
+		if state == nil {
 			return nil
 		}
+		state.status = resp.StatusCode
 
-		// Add error handler
-		proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
-			logger.Error("Proxy error", "service", name, "error", err, "path", r.URL.Path)
-			w.WriteHeader(http.StatusBadGateway)
-			w.Write([]byte(fmt.Sprintf(`{"error": "Service unavailable: %s"}`, err.Error())))
+		// Hand transient failures back to the retry loop while attempts remain
+		if !state.final && isRetryableStatus(resp.StatusCode) {
+			return &retryableStatusError{status: resp.StatusCode}
 		}
+		return nil
+	}
 
-		dp.proxies[name] = proxy
+	// Add error handler
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		// Retryable statuses are handed over as errors but were counted above
+		var statusErr *retryableStatusError
+		if !errors.As(err, &statusErr) {
+			dp.observeUpstream(service, 0, err)
+		}
+
+		if state := attemptStateFrom(r.Context()); state != nil {
+			state.failed = true
+			if !state.final && r.Context().Err() == nil {
+				state.err = err
+				return
+			}
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			dp.logger.Error("Proxy timeout", "service", service, "error", err, "path", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusGatewayTimeout)
+			w.Write([]byte(`{"error": "Service timed out"}`))
+			return
+		}
+
+		dp.logger.Error("Proxy error", "service", service, "error", err, "path", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(fmt.Sprintf(`{"error": "Service unavailable: %s"}`, err.Error())))
 	}
 
-	return dp
+	return proxy
 }
 
 // Handler returns a Gin handler for dynamic proxying
 func (dp *DynamicProxy) Handler() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Only handle /api/v1/* paths
-		if !strings.HasPrefix(c.Request.URL.Path, "/api/v1/") {
-			// Not an API path, let other handlers deal with it
+		// Skip gateway management routes
+		if strings.HasPrefix(c.Request.URL.Path, "/api/v1/gateway/") {
 			c.Next()
 			return
 		}
 
-		// Skip gateway management routes
-		if strings.HasPrefix(c.Request.URL.Path, "/api/v1/gateway/") {
+		match, ok := dp.routes.match(c.Request)
+		if !ok {
+			if strings.HasPrefix(c.Request.URL.Path, "/api/v1/") {
+				c.JSON(http.StatusNotFound, gin.H{"error": "Service not found"})
+				return
+			}
+			// Not an API path, let other handlers deal with it
 			c.Next()
 			return
 		}
 
-		// Extract service name from path
-		// Expected format: /api/v1/{service}/...
-		parts := strings.Split(strings.TrimPrefix(c.Request.URL.Path, "/api/v1/"), "/")
-		if len(parts) == 0 {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Invalid path"})
+		route := match.route
+		serviceName := match.service
+		c.Set("route", route.name)
+		c.Set("route_params", match.params)
+
+		// Services taken from the path must exist, so arbitrary names start
+		// no catalog watches and no per-service state or metric series
+		if route.dynamicService() && !dp.knownService(serviceName) {
+			c.Set("service", unknownService)
+			c.JSON(http.StatusNotFound, gin.H{"error": "Service not found"})
+			return
+		}
+		c.Set("service", serviceName)
+		if dp.observer.RequestStarted != nil {
+			done := dp.observer.RequestStarted(serviceName, route.name)
+			defer done()
+		}
+
+		if route.authRequired {
+			if dp.authenticator == nil {
+				dp.logger.Error("Route requires authentication but no authenticator is configured", "route", route.name)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "authentication unavailable"})
+				return
+			}
+			authStart := time.Now()
+			dp.authenticator(c)
+			c.Set("auth_latency", time.Since(authStart))
+			if c.IsAborted() {
+				return
+			}
+		}
+		if dp.rateLimiter != nil {
+			dp.rateLimiter(c)
+			if c.IsAborted() {
+				return
+			}
+		}
+		dp.ForwardIdentity(c)
+
+		// Apply the route's path rewrite before forwarding
+		if match.upstreamPath != c.Request.URL.Path {
+			dp.logger.Debug("Rewriting request path",
+				"route", route.name,
+				"from", c.Request.URL.Path,
+				"to", match.upstreamPath,
+			)
+			c.Request.URL.Path = match.upstreamPath
+			c.Request.URL.RawPath = ""
+		}
+
+		endpoint := dp.endpointFor(serviceName)
+		if route.timeout > 0 {
+			override := *endpoint
+			override.Timeout = route.timeout
+			if route.streaming {
+				override.StreamTimeout = route.timeout
+			}
+			endpoint = &override
+		}
+
+		// Fail fast while the service breaker is open
+		breaker := dp.breakers.service(serviceName)
+		if breaker != nil && !breaker.allow() {
+			dp.logger.Warn("Circuit open, rejecting request", "service", serviceName, "path", c.Request.URL.Path)
+			c.Header("Retry-After", "5")
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Service temporarily unavailable (circuit open)"})
 			return
 		}
+		result := dp.route(c, serviceName, endpoint, route.streaming)
+		if breaker != nil {
+			if result.blamesUpstream() {
+				breaker.record(result.success, result.latency)
+			} else {
+				breaker.release()
+			}
+		}
+	}
+}
+
+// RouteAuthRequired reports whether req matches the route table and whether
+// the matched route requires authentication, for front ends other than
+// Handler that must admit requests the same way
+func (dp *DynamicProxy) RouteAuthRequired(req *http.Request) (authRequired bool, ok bool) {
+	match, ok := dp.routes.match(req)
+	if !ok {
+		return false, false
+	}
+	return match.route.authRequired, true
+}
+
+// attemptOutcome is the result of the last upstream attempt of a request
+type attemptOutcome struct {
+	success bool
+	latency time.Duration
+	skipped bool // no upstream call was made; nothing to record
+
+	// The upstream was reached but the client side failed, e.g. a
+	// WebSocket handshake with a bad origin; nothing to record either
+	clientError bool
+}
+
+// blamesUpstream reports whether the outcome says something about the
+// upstream and belongs in its circuit breakers
+func (o attemptOutcome) blamesUpstream() bool {
+	return !o.skipped && !o.clientError
+}
+
+// route proxies the request to a discovered instance or the static
+// configuration and reports the outcome of the final attempt
+func (dp *DynamicProxy) route(c *gin.Context, serviceName string, endpoint *config.ServiceEndpoint, streaming bool) attemptOutcome {
+	upgrade := isWebSocketRequest(c.Request)
+	if upgrade && dp.websocket == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "WebSocket proxying is disabled"})
+		return attemptOutcome{skipped: true}
+	}
+
+	// Try to discover service from the Consul-backed catalog first. The catalog
+	// keeps serving the last known instances while Consul is unreachable
+	if dp.registry != nil {
+		_, span := tracing.Start(c.Request.Context(), "consul.select_instance", attribute.String("gateway.service", serviceName))
+		instance, err := dp.selectInstance(serviceName, nil)
+		if instance != nil {
+			span.SetAttributes(attribute.String("gateway.instance", instance.ID))
+		}
+		tracing.End(span, err)
+		if err != nil {
+			if _, anyErr := dp.registry.GetHealthyInstance(serviceName); anyErr == nil {
+				// Instances exist but every one of them is ejected
+				dp.logger.Warn("All instances ejected by circuit breakers", "service", serviceName)
+				c.Header("Retry-After", "5")
+				c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Service temporarily unavailable (all instances ejected)"})
+				return attemptOutcome{skipped: true}
+			}
+		} else {
+			targetURL := dp.instanceURL(instance)
 
-		serviceName := parts[0]
-		
-		// Handle special routing cases for cross-service paths
-		if serviceName == "users" && len(parts) >= 3 && parts[2] == "sessions" {
-			// Route /api/v1/users/{user_id}/sessions to sessions service
-			serviceName = "sessions"
-		}
-		
-		// Try to discover service from Consul first
-		if dp.registry != nil {
-			instance, err := dp.registry.GetHealthyInstance(serviceName)
-			if err == nil {
-				targetURL := fmt.Sprintf("http://%s:%d", instance.Host, instance.Port)
-				
-				// Check if service has SSE tag (for MCP and other streaming services)
-				hasSSE := false
-				for _, tag := range instance.Tags {
-					if tag == "sse" || tag == "streaming" {
-						hasSSE = true
-						break
-					}
+			// Streaming routes and services with an SSE tag (MCP and other streaming services) use the SSE proxy
+			hasSSE := streaming
+			for _, tag := range instance.Tags {
+				if tag == "sse" || tag == "streaming" {
+					hasSSE = true
+					break
 				}
-				
-				dp.logger.Info("Routing to discovered service",
+			}
+
+			dp.logger.Info("Routing to discovered service",
+				"service", serviceName,
+				"instance", instance.ID,
+				"target", targetURL,
+				"path", c.Request.URL.Path,
+				"sse_enabled", hasSSE,
+			)
+
+			dp.watchInstances(serviceName)
+
+			if upgrade {
+				dp.logger.Info("Routing WebSocket to discovered service",
 					"service", serviceName,
 					"instance", instance.ID,
 					"target", targetURL,
 					"path", c.Request.URL.Path,
-					"sse_enabled", hasSSE,
 				)
-				
-				if hasSSE {
-					// Use SSE proxy for services with SSE support
-					sseProxy := NewSSEProxy(targetURL, dp.logger)
-					sseProxy.Handler()(c)
-				} else {
-					// Use regular reverse proxy
-					target, _ := url.Parse(targetURL)
-					proxy := httputil.NewSingleHostReverseProxy(target)
-					
-					// Remove CORS headers from downstream services
-					proxy.ModifyResponse = func(resp *http.Response) error {
-						resp.Header.Del("Access-Control-Allow-Origin")
-						resp.Header.Del("Access-Control-Allow-Methods")
-						resp.Header.Del("Access-Control-Allow-Headers")
-						resp.Header.Del("Access-Control-Allow-Credentials")
-						resp.Header.Del("Access-Control-Max-Age")
-						resp.Header.Del("Access-Control-Expose-Headers")
-						return nil
-					}
-					
-					proxy.ServeHTTP(c.Writer, c.Request)
+
+				// The connection counts as in flight for its whole lifetime
+				done := dp.registry.BeginRequest(instance)
+				defer done()
+				result := dp.websocket.Serve(c, targetURL)
+				if !result.skipped {
+					noteAttempt(c, instance.ID, result.latency)
 				}
-				return
+				dp.recordInstance(serviceName, instance.ID, result)
+				return result
 			}
+
+			sequence := dp.newInstanceSequence(serviceName, instance)
+
+			if hasSSE {
+				// Use SSE proxy for services with SSE support
+				var result attemptOutcome
+				sseProxy := dp.newSSEProxy(serviceName, targetURL, endpoint)
+				sseProxy.retry = dp.retryPolicy(serviceName, endpoint)
+				sseProxy.failover = sequence.nextURL
+				sseProxy.clients = func(target string) *http.Client {
+					return dp.pooledClient(serviceName, sequence.instanceID(target), target)
+				}
+				sseProxy.observe = func(upstream string, status int, err error, latency time.Duration) {
+					result = attemptOutcome{success: err == nil && status < 500, latency: latency}
+					noteAttempt(c, sequence.instanceID(upstream), latency)
+					dp.recordInstance(serviceName, sequence.instanceID(upstream), result)
+					dp.observeUpstream(serviceName, status, err)
+				}
+
+				// Track the request against the instance so least-request balancing sees gateway load
+				done := dp.registry.BeginRequest(instance)
+				defer done()
+				sseProxy.Handler()(c)
+				return result
+			}
+
+			// Use regular reverse proxy, retrying on other instances where allowed
+			return dp.forward(c, serviceName, endpoint, func() (*httputil.ReverseProxy, func(), string, error) {
+				next, release, err := sequence.next()
+				if err != nil {
+					return nil, nil, "", err
+				}
+				upstream, err := dp.pool.get(serviceName, next.ID, dp.instanceURL(next))
+				if err != nil {
+					release()
+					return nil, nil, "", err
+				}
+				return upstream.proxy, release, next.ID, nil
+			})
 		}
-		
-		// Fallback to static configuration
-		proxy, exists := dp.proxies[serviceName]
-		if !exists {
-			dp.logger.Warn("No proxy found for service", "service", serviceName, "path", c.Request.URL.Path)
-			c.JSON(http.StatusNotFound, gin.H{"error": "Service not found"})
-			return
+	}
+
+	// Fallback to static configuration
+	proxy, exists := dp.proxies[serviceName]
+	if !exists {
+		dp.logger.Warn("No proxy found for service", "service", serviceName, "path", c.Request.URL.Path)
+		c.JSON(http.StatusNotFound, gin.H{"error": "Service not found"})
+		return attemptOutcome{skipped: true}
+	}
+
+	// Log the proxy action
+	dp.logger.Info("Routing request (static config)",
+		"service", serviceName,
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"streaming", streaming,
+	)
+
+	if upgrade {
+		result := dp.websocket.Serve(c, dp.staticURL(endpoint))
+		if !result.skipped {
+			noteAttempt(c, staticUpstreamID, result.latency)
+		}
+		return result
+	}
+
+	if streaming {
+		var result attemptOutcome
+		targetURL := dp.staticURL(endpoint)
+		sseProxy := dp.newSSEProxy(serviceName, targetURL, endpoint)
+		sseProxy.client = dp.pooledClient(serviceName, staticUpstreamID, targetURL)
+		sseProxy.retry = dp.retryPolicy(serviceName, endpoint)
+		sseProxy.observe = func(upstream string, status int, err error, latency time.Duration) {
+			result = attemptOutcome{success: err == nil && status < 500, latency: latency}
+			noteAttempt(c, staticUpstreamID, latency)
+			dp.observeUpstream(serviceName, status, err)
+		}
+		sseProxy.Handler()(c)
+		return result
+	}
+
+	// Use the reverse proxy to handle the request
+	return dp.forward(c, serviceName, endpoint, func() (*httputil.ReverseProxy, func(), string, error) {
+		return proxy, func() {}, "static", nil
+	})
+}
+
+// newSSEProxy creates an SSE proxy with the gateway's streaming settings
+func (dp *DynamicProxy) newSSEProxy(service, targetURL string, endpoint *config.ServiceEndpoint) *SSEProxy {
+	sseProxy := NewSSEProxy(targetURL, endpoint, dp.logger)
+	sseProxy.keepalive = dp.config.Proxy.SSE.KeepaliveInterval
+	sseProxy.replay = dp.replay
+	sseProxy.streamIDHeader = dp.config.Proxy.SSE.StreamIDHeader
+	sseProxy.maxEventSize = dp.config.Proxy.SSE.MaxEventSize
+	sseProxy.identityHeader = dp.identityHeader
+	sseProxy.observeStream = func(stats StreamStats) {
+		dp.recordStream(service, stats)
+	}
+	return sseProxy
+}
+
+// recordStream adds the counters of a finished stream to the service totals
+func (dp *DynamicProxy) recordStream(service string, stats StreamStats) {
+	dp.streamMu.Lock()
+	defer dp.streamMu.Unlock()
+
+	totals, exists := dp.streamTotals[service]
+	if !exists {
+		totals = &StreamTotals{}
+		dp.streamTotals[service] = totals
+	}
+	totals.Streams++
+	if stats.Completed {
+		totals.Completed++
+	}
+	if stats.Disconnected {
+		totals.Disconnected++
+	}
+	totals.Events += stats.Events
+	totals.Replayed += stats.Replayed
+	totals.Bytes += stats.Bytes
+
+	if dp.observer.StreamFinished != nil {
+		dp.observer.StreamFinished(service, stats)
+	}
+}
+
+// StreamStats returns the SSE stream counters of every service
+func (dp *DynamicProxy) StreamStats() map[string]StreamTotals {
+	dp.streamMu.Lock()
+	defer dp.streamMu.Unlock()
+
+	result := make(map[string]StreamTotals, len(dp.streamTotals))
+	for service, totals := range dp.streamTotals {
+		result[service] = *totals
+	}
+	return result
+}
+
+// pooledClient returns the pooled HTTP client of an upstream instance
+func (dp *DynamicProxy) pooledClient(service, instanceID, baseURL string) *http.Client {
+	upstream, err := dp.pool.get(service, instanceID, baseURL)
+	if err != nil {
+		dp.logger.Error("Failed to get pooled upstream", "service", service, "target", baseURL, "error", err)
+		return &http.Client{}
+	}
+	return upstream.client
+}
+
+// watchInstances subscribes once per service to catalog changes so pooled
+// upstreams, instance breakers and in-flight counters are dropped when an
+// instance goes away
+func (dp *DynamicProxy) watchInstances(service string) {
+	dp.watchMu.Lock()
+	if dp.watched[service] {
+		dp.watchMu.Unlock()
+		return
+	}
+	dp.watched[service] = true
+	dp.watchMu.Unlock()
+
+	err := dp.registry.WatchService(service, func(instances []*registry.ServiceInstance) {
+		present := make(map[string]bool, len(instances))
+		for _, instance := range instances {
+			present[instance.ID] = true
+		}
+		dp.pool.evict(service, present)
+		dp.breakers.forget(service, present)
+		dp.registry.ForgetInstances(service, present)
+	})
+	if err != nil {
+		dp.logger.Warn("Failed to watch service for pool eviction", "service", service, "error", err)
+	}
+}
+
+// Close releases pooled upstream connections
+func (dp *DynamicProxy) Close() {
+	dp.stopTLS()
+	dp.pool.close()
+}
+
+// ejectedFilter returns an instance filter that skips instances ejected by their breaker
+func (dp *DynamicProxy) ejectedFilter(service string) func(*registry.ServiceInstance) bool {
+	return func(instance *registry.ServiceInstance) bool {
+		return dp.breakers.isEjected(service, instance.ID)
+	}
+}
+
+// selectInstance picks an instance its breaker admits, reserving a probe
+// slot when the breaker is half-open. An instance whose breaker rejects the
+// call is treated as ejected and another one is picked. exclude may be nil
+func (dp *DynamicProxy) selectInstance(service string, exclude func(*registry.ServiceInstance) bool) (*registry.ServiceInstance, error) {
+	ejected := dp.ejectedFilter(service)
+	rejected := make(map[string]bool)
+	for {
+		instance, err := dp.registry.SelectInstance(service, func(candidate *registry.ServiceInstance) bool {
+			return rejected[candidate.ID] || ejected(candidate) || (exclude != nil && exclude(candidate))
+		})
+		if err != nil {
+			return nil, err
+		}
+		breaker := dp.breakers.instance(service, instance.ID)
+		if breaker == nil || breaker.allow() {
+			return instance, nil
 		}
+		rejected[instance.ID] = true
+	}
+}
 
-		// Log the proxy action
-		dp.logger.Info("Routing request (static config)", 
-			"service", serviceName,
-			"method", c.Request.Method,
+// recordInstance feeds the outcome of an attempt into the instance breaker
+func (dp *DynamicProxy) recordInstance(service, instanceID string, result attemptOutcome) {
+	breaker := dp.breakers.instance(service, instanceID)
+	if breaker == nil {
+		return
+	}
+	if result.blamesUpstream() {
+		breaker.record(result.success, result.latency)
+	} else {
+		breaker.release()
+	}
+}
+
+// BreakerStatus returns the state of all circuit breakers, grouped by service
+func (dp *DynamicProxy) BreakerStatus() map[string]interface{} {
+	return dp.breakers.statuses()
+}
+
+// upstreamPicker returns the proxy to use for the next attempt, a function
+// releasing it afterwards and a label identifying the upstream
+type upstreamPicker func() (*httputil.ReverseProxy, func(), string, error)
+
+// forward proxies a request under the service deadline, retrying idempotent
+// requests with exponential backoff while attempts and retry budget remain
+func (dp *DynamicProxy) forward(c *gin.Context, service string, endpoint *config.ServiceEndpoint, pick upstreamPicker) attemptOutcome {
+	ctx := c.Request.Context()
+	if endpoint.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, endpoint.Timeout)
+		defer cancel()
+	}
+
+	policy := dp.retryPolicy(service, endpoint)
+	policy.budget.recordRequest()
+
+	retryable := isIdempotent(c.Request.Method) && policy.maxAttempts > 1
+	if retryable {
+		replayable, err := bufferBody(c.Request)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+			return attemptOutcome{skipped: true}
+		}
+		retryable = replayable
+	}
+
+	for attempt := 1; ; attempt++ {
+		delay := policy.delay(attempt)
+		state := &attemptState{final: !retryable || !policy.canRetry(ctx, attempt, delay)}
+
+		proxy, release, upstream, err := pick()
+		if err != nil {
+			dp.logger.Error("No upstream available for retry", "service", service, "attempt", attempt, "error", err)
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Service unavailable"})
+			return attemptOutcome{}
+		}
+
+		if attempt > 1 {
+			if err := rewindBody(c.Request); err != nil {
+				release()
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to replay request body"})
+				return attemptOutcome{}
+			}
+		}
+
+		start := time.Now()
+		proxy.ServeHTTP(c.Writer, c.Request.WithContext(withAttemptState(ctx, state)))
+		release()
+
+		result := attemptOutcome{
+			success: !state.failed && state.status < http.StatusInternalServerError,
+			latency: time.Since(start),
+		}
+		noteAttempt(c, upstream, result.latency)
+		dp.recordInstance(service, upstream, result)
+
+		if state.err == nil {
+			return result
+		}
+
+		policy.budget.spend()
+		dp.logger.Warn("Retrying upstream request",
+			"service", service,
+			"upstream", upstream,
+			"attempt", attempt,
+			"backoff", delay,
+			"error", state.err,
 			"path", c.Request.URL.Path,
 		)
 
-		// Use the reverse proxy to handle the request
-		proxy.ServeHTTP(c.Writer, c.Request)
+		if !sleepContext(ctx, delay) {
+			c.JSON(http.StatusGatewayTimeout, gin.H{"error": "Service timed out"})
+			return attemptOutcome{}
+		}
 	}
 }
 
+// knownService reports whether a service is configured or registered in Consul
+func (dp *DynamicProxy) knownService(service string) bool {
+	if _, exists := dp.services[service]; exists {
+		return true
+	}
+	return dp.registry != nil && dp.registry.HasService(service)
+}
+
+// endpointFor returns the configuration of a service, or defaults for
+// services known only to Consul
+func (dp *DynamicProxy) endpointFor(service string) *config.ServiceEndpoint {
+	if endpoint, exists := dp.services[service]; exists {
+		return endpoint
+	}
+	return dp.defaultEndpoint
+}
+
+// retryPolicy returns the shared retry policy of a service
+func (dp *DynamicProxy) retryPolicy(service string, endpoint *config.ServiceEndpoint) *retryPolicy {
+	dp.retryMu.Lock()
+	defer dp.retryMu.Unlock()
+
+	policy, exists := dp.retryPolicies[service]
+	if !exists {
+		policy = newRetryPolicy(endpoint.Retry)
+		dp.retryPolicies[service] = policy
+	}
+	return policy
+}
+
+// instanceSequence hands out instances of a service for successive attempts,
+// preferring instances that have not been tried yet. Every instance handed
+// out has been admitted by its breaker, the first one when it was selected
+type instanceSequence struct {
+	dp      *DynamicProxy
+	service string
+	first   *registry.ServiceInstance
+	tried   map[string]bool
+	urls    map[string]string // base URL -> instance ID
+}
+
+func (dp *DynamicProxy) newInstanceSequence(service string, first *registry.ServiceInstance) *instanceSequence {
+	return &instanceSequence{
+		dp:      dp,
+		service: service,
+		first:   first,
+		tried:   make(map[string]bool),
+		urls:    map[string]string{dp.instanceURL(first): first.ID},
+	}
+}
+
+// instanceID maps a base URL handed out by the sequence back to its instance
+func (s *instanceSequence) instanceID(baseURL string) string {
+	if id, exists := s.urls[baseURL]; exists {
+		return id
+	}
+	return baseURL
+}
+
+// next returns the instance for the next attempt along with a function that
+// releases its in-flight slot
+func (s *instanceSequence) next() (*registry.ServiceInstance, func(), error) {
+	instance := s.first
+	if instance != nil {
+		s.first = nil
+	} else {
+		var err error
+		instance, err = s.dp.selectInstance(s.service, func(candidate *registry.ServiceInstance) bool {
+			return s.tried[candidate.ID]
+		})
+		if err != nil {
+			// Every instance has been tried; fall back to any admitted one
+			instance, err = s.dp.selectInstance(s.service, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	s.tried[instance.ID] = true
+	s.urls[s.dp.instanceURL(instance)] = instance.ID
+	return instance, s.dp.registry.BeginRequest(instance), nil
+}
+
+// nextURL is the failover hook used by the SSE proxy
+func (s *instanceSequence) nextURL() (string, func(), error) {
+	// The SSE proxy already holds the first instance
+	if s.first != nil {
+		s.tried[s.first.ID] = true
+		s.first = nil
+	}
+
+	instance, release, err := s.next()
+	if err != nil {
+		return "", nil, err
+	}
+	return s.dp.instanceURL(instance), release, nil
+}
+
+// instanceURL returns the base URL of a discovered instance
+func (dp *DynamicProxy) instanceURL(instance *registry.ServiceInstance) string {
+	return fmt.Sprintf("%s://%s:%d", dp.scheme, instance.Host, instance.Port)
+}
+
+// staticURL returns the base URL of a statically configured service
+func (dp *DynamicProxy) staticURL(endpoint *config.ServiceEndpoint) string {
+	return fmt.Sprintf("%s://%s:%d", dp.scheme, endpoint.Host, endpoint.HTTPPort)
+}
+
 // HealthCheck checks if all backend services are healthy
 func (dp *DynamicProxy) HealthCheck() map[string]bool {
 	health := make(map[string]bool)
-	
+
 	for name, svc := range dp.services {
-		url := fmt.Sprintf("http://%s:%d/health", svc.Host, svc.HTTPPort)
-		resp, err := http.Get(url)
+		baseURL := dp.staticURL(svc)
+		resp, err := dp.pooledClient(name, staticUpstreamID, baseURL).Get(baseURL + "/health")
 		if err != nil {
 			health[name] = false
 			continue
@@ -203,6 +939,6 @@ func (dp *DynamicProxy) HealthCheck() map[string]bool {
 		resp.Body.Close()
 		health[name] = resp.StatusCode == http.StatusOK
 	}
-	
+
 	return health
-}
\ No newline at end of file
+}
@@ -0,0 +1,248 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+)
+
+const (
+	// maxReplayBodyBytes is the largest request body buffered so it can be replayed on retry
+	maxReplayBodyBytes = 1 << 20
+
+	// retryBudgetWindow is the period over which the retry budget is accounted
+	retryBudgetWindow = 10 * time.Second
+
+	defaultRetryBudgetRatio = 0.2
+	defaultRetryBudgetMin   = 10
+	defaultRetryMaxBackoff  = 10 * time.Second
+)
+
+// retryPolicy describes how requests to one service are retried
+type retryPolicy struct {
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	budget      *retryBudget
+}
+
+// newRetryPolicy builds a retry policy from service configuration
+func newRetryPolicy(cfg config.RetryConfig) *retryPolicy {
+	policy := &retryPolicy{
+		maxAttempts: cfg.MaxAttempts,
+		backoff:     cfg.Backoff,
+		maxBackoff:  cfg.MaxBackoff,
+		budget:      newRetryBudget(cfg.BudgetRatio, cfg.BudgetMin),
+	}
+	if policy.maxAttempts < 1 {
+		policy.maxAttempts = 1
+	}
+	if policy.maxBackoff <= 0 {
+		policy.maxBackoff = defaultRetryMaxBackoff
+	}
+	return policy
+}
+
+// delay returns the backoff before the given retry (1-based), using
+// exponential growth with equal jitter
+func (p *retryPolicy) delay(retry int) time.Duration {
+	if p.backoff <= 0 {
+		return 0
+	}
+
+	d := p.backoff
+	for i := 1; i < retry && d < p.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.maxBackoff {
+		d = p.maxBackoff
+	}
+
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// canRetry reports whether another attempt, started after delay, may follow
+// the given attempt within the request deadline and the service retry budget
+func (p *retryPolicy) canRetry(ctx context.Context, attempt int, delay time.Duration) bool {
+	if attempt >= p.maxAttempts {
+		return false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+		return false
+	}
+	return p.budget.available()
+}
+
+// retryBudget caps retries to a fraction of recent requests so a failing
+// backend doesn't turn into a retry storm
+type retryBudget struct {
+	mu          sync.Mutex
+	ratio       float64
+	minRetries  int
+	windowStart time.Time
+	requests    int
+	retries     int
+}
+
+func newRetryBudget(ratio float64, minRetries int) *retryBudget {
+	if ratio <= 0 {
+		ratio = defaultRetryBudgetRatio
+	}
+	if minRetries <= 0 {
+		minRetries = defaultRetryBudgetMin
+	}
+	return &retryBudget{
+		ratio:       ratio,
+		minRetries:  minRetries,
+		windowStart: time.Now(),
+	}
+}
+
+// recordRequest counts an original (non-retry) request
+func (b *retryBudget) recordRequest() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll()
+	b.requests++
+}
+
+// available reports whether the budget currently allows a retry
+func (b *retryBudget) available() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll()
+	return b.retries < b.limit()
+}
+
+// spend consumes one retry from the budget
+func (b *retryBudget) spend() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll()
+	b.retries++
+}
+
+func (b *retryBudget) limit() int {
+	limit := int(float64(b.requests) * b.ratio)
+	if limit < b.minRetries {
+		limit = b.minRetries
+	}
+	return limit
+}
+
+func (b *retryBudget) roll() {
+	if time.Since(b.windowStart) >= retryBudgetWindow {
+		b.windowStart = time.Now()
+		b.requests = 0
+		b.retries = 0
+	}
+}
+
+// isIdempotent reports whether a request with this method may be retried safely
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether an upstream status indicates a transient failure
+func isRetryableStatus(code int) bool {
+	return code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout
+}
+
+// bufferBody makes the request body replayable if it is small enough. It
+// reports false when the body had to be streamed and cannot be retried
+func bufferBody(req *http.Request) (bool, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true, nil
+	}
+	if req.ContentLength > maxReplayBodyBytes {
+		return false, nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(req.Body, maxReplayBodyBytes+1))
+	if err != nil {
+		return false, fmt.Errorf("failed to read request body: %w", err)
+	}
+	if len(buf) > maxReplayBodyBytes {
+		// Too large to buffer; stitch the consumed prefix back in front of the rest
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(buf), req.Body), req.Body}
+		return false, nil
+	}
+
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(buf))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf)), nil
+	}
+	return true, nil
+}
+
+// rewindBody resets a buffered request body before the next attempt
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// attemptState carries per-attempt retry bookkeeping through a reverse proxy
+type attemptState struct {
+	final  bool  // the last permitted attempt: errors go to the client
+	err    error // set when a non-final attempt failed and should be retried
+	status int   // upstream status code, if a response was received
+	failed bool  // the attempt failed (transport error or retryable status)
+}
+
+type attemptStateKey struct{}
+
+func withAttemptState(ctx context.Context, state *attemptState) context.Context {
+	return context.WithValue(ctx, attemptStateKey{}, state)
+}
+
+func attemptStateFrom(ctx context.Context) *attemptState {
+	state, _ := ctx.Value(attemptStateKey{}).(*attemptState)
+	return state
+}
+
+// retryableStatusError signals that an upstream returned a transient status
+type retryableStatusError struct {
+	status int
+}
+
+func (e *retryableStatusError) Error() string {
+	return fmt.Sprintf("upstream returned retryable status %d", e.status)
+}
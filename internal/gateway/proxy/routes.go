@@ -0,0 +1,320 @@
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+)
+
+// defaultRoutes reproduces the historical /api/v1/{service} routing and is
+// used when the configuration does not declare a route table
+func defaultRoutes() []config.RouteConfig {
+	return []config.RouteConfig{
+		{
+			Name:    "user-sessions",
+			Path:    "/api/v1/users/{user_id}/sessions/**",
+			Service: "sessions",
+		},
+		{
+			Name:    "agents",
+			Path:    "/api/v1/agents/**",
+			Service: "agents",
+		},
+		{
+			Name:    "models",
+			Path:    "/api/v1/models/**",
+			Service: "models",
+		},
+		{
+			Name:        "mcp",
+			Path:        "/api/v1/mcp/**",
+			Service:     "mcp",
+			StripPrefix: "/api/v1/mcp",
+		},
+		{
+			Name:    "services",
+			Path:    "/api/v1/{service}/**",
+			Service: "{service}",
+		},
+	}
+}
+
+// segmentKind is the type of one path template segment
+type segmentKind int
+
+const (
+	segmentLiteral  segmentKind = iota
+	segmentParam                // {name}: exactly one segment, captured
+	segmentWildcard             // *: exactly one segment
+	segmentRest                 // **: any remaining segments, captured as "**"
+)
+
+type pathSegment struct {
+	kind  segmentKind
+	value string // literal text or parameter name
+}
+
+// compiledRoute is a route table entry prepared for matching
+type compiledRoute struct {
+	name         string
+	segments     []pathSegment
+	methods      map[string]bool
+	headers      map[string]string
+	service      string
+	stripPrefix  string
+	rewrite      string
+	authRequired bool
+	timeout      time.Duration
+	streaming    bool
+}
+
+// unknownService labels requests for services named in the path that are
+// neither configured nor registered
+const unknownService = "unknown"
+
+// dynamicService reports whether the target service is taken from the path
+func (r *compiledRoute) dynamicService() bool {
+	return strings.Contains(r.service, "{")
+}
+
+// routeMatch is the result of matching a request against the route table
+type routeMatch struct {
+	route        *compiledRoute
+	service      string
+	params       map[string]string
+	upstreamPath string
+}
+
+// routeTable matches requests against compiled routes in declaration order
+type routeTable struct {
+	routes []*compiledRoute
+}
+
+// compileRoutes validates and compiles a route table
+func compileRoutes(routes []config.RouteConfig) (*routeTable, error) {
+	table := &routeTable{}
+	names := make(map[string]bool, len(routes))
+
+	for i, route := range routes {
+		name := route.Name
+		if name == "" {
+			name = fmt.Sprintf("route-%d", i)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("duplicate route name: %s", name)
+		}
+		names[name] = true
+
+		if route.Service == "" {
+			return nil, fmt.Errorf("route %s: service is required", name)
+		}
+		if route.StripPrefix != "" && route.Rewrite != "" {
+			return nil, fmt.Errorf("route %s: strip_prefix and rewrite are mutually exclusive", name)
+		}
+
+		segments, err := parsePathTemplate(route.Path)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %w", name, err)
+		}
+
+		params := make(map[string]bool)
+		for _, segment := range segments {
+			switch segment.kind {
+			case segmentParam:
+				params[segment.value] = true
+			case segmentRest:
+				params["**"] = true
+			}
+		}
+		for _, template := range []string{route.Service, route.Rewrite} {
+			for _, ref := range templateRefs(template) {
+				if !params[ref] {
+					return nil, fmt.Errorf("route %s: template references unknown parameter {%s}", name, ref)
+				}
+			}
+		}
+
+		compiled := &compiledRoute{
+			name:         name,
+			segments:     segments,
+			headers:      route.Headers,
+			service:      route.Service,
+			stripPrefix:  strings.TrimSuffix(route.StripPrefix, "/"),
+			rewrite:      route.Rewrite,
+			authRequired: route.AuthRequired,
+			timeout:      route.Timeout,
+			streaming:    route.Streaming,
+		}
+		if len(route.Methods) > 0 {
+			compiled.methods = make(map[string]bool, len(route.Methods))
+			for _, method := range route.Methods {
+				compiled.methods[strings.ToUpper(method)] = true
+			}
+		}
+
+		table.routes = append(table.routes, compiled)
+	}
+
+	return table, nil
+}
+
+// parsePathTemplate splits a path template into segments
+func parsePathTemplate(path string) ([]pathSegment, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path %q must start with /", path)
+	}
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	segments := make([]pathSegment, 0, len(parts))
+	for i, part := range parts {
+		switch {
+		case part == "" && len(parts) == 1:
+			// Root path
+		case part == "":
+			return nil, fmt.Errorf("path %q contains an empty segment", path)
+		case part == "**":
+			if i != len(parts)-1 {
+				return nil, fmt.Errorf("path %q: ** is only allowed as the last segment", path)
+			}
+			segments = append(segments, pathSegment{kind: segmentRest})
+		case part == "*":
+			segments = append(segments, pathSegment{kind: segmentWildcard})
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+			name := part[1 : len(part)-1]
+			if name == "" || strings.ContainsAny(name, "{}*/") {
+				return nil, fmt.Errorf("path %q has an invalid parameter %q", path, part)
+			}
+			segments = append(segments, pathSegment{kind: segmentParam, value: name})
+		case strings.ContainsAny(part, "{}*"):
+			return nil, fmt.Errorf("path %q: segment %q mixes literals and parameters", path, part)
+		default:
+			segments = append(segments, pathSegment{kind: segmentLiteral, value: part})
+		}
+	}
+	return segments, nil
+}
+
+// templateRefs returns the parameter names referenced as {name} in a template
+func templateRefs(template string) []string {
+	var refs []string
+	for {
+		start := strings.Index(template, "{")
+		if start < 0 {
+			return refs
+		}
+		end := strings.Index(template[start:], "}")
+		if end < 0 {
+			return refs
+		}
+		refs = append(refs, template[start+1:start+end])
+		template = template[start+end+1:]
+	}
+}
+
+// expandTemplate substitutes {name} references with captured parameters
+func expandTemplate(template string, params map[string]string) string {
+	for name, value := range params {
+		template = strings.ReplaceAll(template, "{"+name+"}", value)
+	}
+	return template
+}
+
+// match returns the first route that matches the request
+func (t *routeTable) match(req *http.Request) (*routeMatch, bool) {
+	path := req.URL.Path
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) == 1 && parts[0] == "" {
+		parts = nil
+	}
+
+	for _, route := range t.routes {
+		if route.methods != nil && !route.methods[req.Method] {
+			continue
+		}
+		if !route.matchHeaders(req.Header) {
+			continue
+		}
+		params, ok := route.matchPath(parts)
+		if !ok {
+			continue
+		}
+
+		service := expandTemplate(route.service, params)
+		if service == "" || strings.ContainsAny(service, "/{}") {
+			continue
+		}
+
+		return &routeMatch{
+			route:        route,
+			service:      service,
+			params:       params,
+			upstreamPath: route.upstreamPath(path, params),
+		}, true
+	}
+	return nil, false
+}
+
+func (r *compiledRoute) matchHeaders(header http.Header) bool {
+	for name, expected := range r.headers {
+		value := header.Get(name)
+		if expected == "*" {
+			if value == "" {
+				return false
+			}
+			continue
+		}
+		if value != expected {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *compiledRoute) matchPath(parts []string) (map[string]string, bool) {
+	params := make(map[string]string)
+	for i, segment := range r.segments {
+		if segment.kind == segmentRest {
+			params["**"] = strings.Join(parts[i:], "/")
+			return params, true
+		}
+		if i >= len(parts) {
+			return nil, false
+		}
+		switch segment.kind {
+		case segmentLiteral:
+			if parts[i] != segment.value {
+				return nil, false
+			}
+		case segmentParam:
+			params[segment.value] = parts[i]
+		}
+	}
+	if len(parts) != len(r.segments) {
+		return nil, false
+	}
+	return params, true
+}
+
+// upstreamPath applies the rewrite or strip_prefix rule of the route
+func (r *compiledRoute) upstreamPath(path string, params map[string]string) string {
+	switch {
+	case r.rewrite != "":
+		rewritten := expandTemplate(r.rewrite, params)
+		// Collapse the empty remainder of a ** capture
+		rewritten = strings.ReplaceAll(rewritten, "//", "/")
+		if !strings.HasPrefix(rewritten, "/") {
+			rewritten = "/" + rewritten
+		}
+		return rewritten
+	case r.stripPrefix != "":
+		stripped := strings.TrimPrefix(path, r.stripPrefix)
+		if !strings.HasPrefix(stripped, "/") {
+			stripped = "/" + stripped
+		}
+		return stripped
+	}
+	return path
+}
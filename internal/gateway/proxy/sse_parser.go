@@ -0,0 +1,136 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// defaultMaxSSEEventSize bounds the memory held for a single event
+const defaultMaxSSEEventSize = 16 << 20
+
+// errSSEEventTooLarge is returned when an event exceeds the configured size
+var errSSEEventTooLarge = errors.New("SSE event exceeds maximum size")
+
+// sseEvent is one complete server-sent event as relayed to the client
+type sseEvent struct {
+	raw       []byte // the event's lines including the terminating blank line
+	id        string
+	name      string
+	dataLines int
+	retry     time.Duration // reconnection time requested by the upstream, if any
+	comment   bool          // the event consists of comment lines only
+}
+
+// sseParser reads server-sent events frame by frame. Lines may be of any
+// length up to the event size limit and may end in LF, CRLF or CR
+type sseParser struct {
+	reader       *bufio.Reader
+	maxEventSize int
+	line         []byte
+	skipLF       bool // the previous line ended in CR; a following LF belongs to it
+}
+
+func newSSEParser(r io.Reader, maxEventSize int) *sseParser {
+	if maxEventSize <= 0 {
+		maxEventSize = defaultMaxSSEEventSize
+	}
+	return &sseParser{
+		reader:       bufio.NewReaderSize(r, 32<<10),
+		maxEventSize: maxEventSize,
+	}
+}
+
+// next returns the next event, with its lines normalized to LF endings. It
+// returns io.EOF once the stream has ended
+func (p *sseParser) next() (sseEvent, error) {
+	var event sseEvent
+	event.comment = true
+
+	for {
+		line, err := p.readLine(p.maxEventSize - len(event.raw))
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(event.raw) > 0 {
+				// Deliver a trailing event the upstream did not terminate
+				event.raw = append(event.raw, '\n')
+				return event, nil
+			}
+			return sseEvent{}, err
+		}
+
+		// A blank line dispatches the event; extra blank lines are ignored
+		if len(line) == 0 {
+			if len(event.raw) == 0 {
+				continue
+			}
+			event.raw = append(event.raw, '\n')
+			return event, nil
+		}
+
+		event.raw = append(event.raw, line...)
+		event.raw = append(event.raw, '\n')
+		if line[0] == ':' {
+			continue
+		}
+		event.comment = false
+
+		field, value := line, []byte(nil)
+		if i := bytes.IndexByte(line, ':'); i >= 0 {
+			field, value = line[:i], line[i+1:]
+			value = bytes.TrimPrefix(value, []byte(" "))
+		}
+
+		switch string(field) {
+		case "id":
+			// Ids containing NUL are ignored per the SSE specification
+			if bytes.IndexByte(value, 0) < 0 {
+				event.id = string(value)
+			}
+		case "event":
+			event.name = string(value)
+		case "data":
+			event.dataLines++
+		case "retry":
+			if ms, err := strconv.ParseUint(string(value), 10, 32); err == nil {
+				event.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
+
+// readLine returns the next line without its terminator. The returned slice
+// is only valid until the next call
+func (p *sseParser) readLine(limit int) ([]byte, error) {
+	p.line = p.line[:0]
+	for {
+		b, err := p.reader.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(p.line) > 0 {
+				return p.line, nil
+			}
+			return nil, err
+		}
+
+		switch b {
+		case '\n':
+			if p.skipLF {
+				p.skipLF = false
+				continue
+			}
+			return p.line, nil
+		case '\r':
+			p.skipLF = true
+			return p.line, nil
+		}
+		p.skipLF = false
+
+		if len(p.line) >= limit {
+			return nil, fmt.Errorf("%w (%d bytes)", errSSEEventTooLarge, p.maxEventSize)
+		}
+		p.line = append(p.line, b)
+	}
+}
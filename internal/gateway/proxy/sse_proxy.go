@@ -1,31 +1,97 @@
 package proxy
 
 import (
-	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
 	"github.com/isa-cloud/isa_cloud/pkg/logger"
 )
 
 // SSEProxy handles Server-Sent Events proxying
 type SSEProxy struct {
-	targetURL string
-	logger    *logger.Logger
-	timeout   time.Duration
+	targetURL      string
+	logger         *logger.Logger
+	timeout        time.Duration // deadline for SSE streams
+	requestTimeout time.Duration // deadline for regular requests
+	client         *http.Client
+
+	// retry and failover are optional; without them a single attempt is made
+	// against targetURL
+	retry    *retryPolicy
+	failover func() (string, func(), error)
+
+	// clients, if set, supplies the pooled client for an upstream base URL
+	clients func(target string) *http.Client
+
+	// keepalive is the interval of comment lines sent on quiet streams (0 disables)
+	keepalive time.Duration
+
+	// replay, if set, buffers events of streams named by streamIDHeader
+	replay         *replayStore
+	streamIDHeader string
+
+	// observe, if set, is told the outcome of every upstream attempt
+	observe func(upstream string, status int, err error, latency time.Duration)
+
+	// cancel is the optional endpoint notified when a client abandons a stream
+	cancel config.CancelConfig
+
+	// maxEventSize bounds a single upstream event (0 uses the default)
+	maxEventSize int
+
+	// observeStream, if set, receives the counters of every finished stream
+	observeStream func(StreamStats)
+
+	// identityHeader is the gateway-signed identity, also sent to the cancel endpoint
+	identityHeader string
 }
 
-// NewSSEProxy creates a new SSE proxy handler
-func NewSSEProxy(targetURL string, logger *logger.Logger) *SSEProxy {
-	return &SSEProxy{
-		targetURL: targetURL,
-		logger:    logger,
-		timeout:   30 * time.Minute, // Long timeout for SSE connections
+// StreamStats summarises one proxied SSE stream
+type StreamStats struct {
+	Target       string
+	Path         string
+	Events       int64 // events relayed to the client, excluding comments
+	Replayed     int64 // events served from the replay buffer
+	Bytes        int64 // bytes written to the client, including keepalives
+	Duration     time.Duration
+	Completed    bool // the upstream ended the stream cleanly
+	Disconnected bool // the client went away before the stream ended
+}
+
+// NewSSEProxy creates a new SSE proxy handler using the timeouts and retry
+// settings of the given service endpoint (nil uses defaults)
+func NewSSEProxy(targetURL string, endpoint *config.ServiceEndpoint, logger *logger.Logger) *SSEProxy {
+	p := &SSEProxy{
+		targetURL:      targetURL,
+		logger:         logger,
+		timeout:        30 * time.Minute, // Long timeout for SSE connections
+		requestTimeout: 30 * time.Second,
+		client:         &http.Client{Transport: tracing.Transport(http.DefaultTransport, "sse")},
+		retry:          newRetryPolicy(config.RetryConfig{}),
+	}
+
+	if endpoint != nil {
+		if endpoint.StreamTimeout > 0 {
+			p.timeout = endpoint.StreamTimeout
+		}
+		if endpoint.Timeout > 0 {
+			p.requestTimeout = endpoint.Timeout
+		}
+		p.retry = newRetryPolicy(endpoint.Retry)
+		p.cancel = endpoint.Cancel
 	}
+
+	return p
 }
 
 // Handler returns a Gin handler for SSE proxying
@@ -46,82 +112,85 @@ func (p *SSEProxy) Handler() gin.HandlerFunc {
 
 // proxySSE handles SSE-specific proxying
 func (p *SSEProxy) proxySSE(c *gin.Context) {
-	// For agents and models services, preserve the full path as they expect /api/v1/{service}/ prefix
+	// The route table has already applied any strip/rewrite rule
 	path := c.Request.URL.Path
 	
-	// Check if this is an agents or models service that needs full path preservation
-	if strings.HasPrefix(path, "/api/v1/agents/") || strings.HasPrefix(path, "/api/v1/models/") {
-		// Keep the full path including /api/v1/{service}/
-		// These services handle their own routing with the full prefix
-	} else if strings.HasPrefix(path, "/api/v1/") {
-		// For other services, strip the /api/v1/{service} prefix
-		parts := strings.SplitN(strings.TrimPrefix(path, "/api/v1/"), "/", 2)
-		if len(parts) > 1 {
-			// Keep everything after the service name
-			path = "/" + parts[1]
-		} else {
-			// Just the service name, no additional path
-			path = "/"
-		}
-	}
-	
-	// Build target URL
-	targetURL := p.targetURL + path
-	if c.Request.URL.RawQuery != "" {
-		targetURL += "?" + c.Request.URL.RawQuery
-	}
-
 	p.logger.Debug("Proxying SSE request", 
-		"target", targetURL,
+		"target", p.targetURL+path,
 		"method", c.Request.Method,
 		"path", c.Request.URL.Path,
 	)
 
-	// Create request to target
-	req, err := http.NewRequestWithContext(c.Request.Context(), c.Request.Method, targetURL, c.Request.Body)
-	if err != nil {
-		p.logger.Error("Failed to create SSE proxy request", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create proxy request"})
-		return
-	}
+	stats := &StreamStats{Target: p.targetURL, Path: path}
+	start := time.Now()
+	defer func() {
+		stats.Duration = time.Since(start)
+		p.finishStream(stats)
+	}()
 
-	// Copy headers
-	for key, values := range c.Request.Header {
-		// Skip hop-by-hop headers
-		if isHopByHopHeader(key) {
-			continue
-		}
-		for _, value := range values {
-			req.Header.Add(key, value)
+	// Resume from the replay buffer when the client reconnects with a known event id
+	streamID := p.streamID(c)
+	lastEventID := c.GetHeader("Last-Event-ID")
+	var replayed []sseEvent
+	if streamID != "" {
+		// A stream abandoned earlier may still be buffering its upstream
+		p.replay.takeOver(streamID)
+		if lastEventID == "" {
+			p.replay.reset(streamID)
+		} else if events, completed, found := p.replay.since(streamID, lastEventID); found {
+			p.logger.Debug("Resuming SSE stream from replay buffer",
+				"stream_id", streamID,
+				"last_event_id", lastEventID,
+				"buffered_events", len(events),
+				"completed", completed,
+			)
+			if completed {
+				// The upstream already finished; serve the remainder from the buffer
+				p.startStream(c, http.StatusOK)
+				stats.Replayed = int64(len(events))
+				stats.Completed = p.writeEvents(c, events, stats)
+				return
+			}
+			replayed = events
+			if len(events) > 0 {
+				lastEventID = events[len(events)-1].id
+			}
 		}
 	}
 
-	// Ensure SSE headers
-	// MCP requires both application/json and text/event-stream
-	if !strings.Contains(req.Header.Get("Accept"), "text/event-stream") {
-		currentAccept := req.Header.Get("Accept")
-		if currentAccept == "" {
-			req.Header.Set("Accept", "application/json, text/event-stream")
-		} else {
-			req.Header.Set("Accept", currentAccept+", text/event-stream")
+	// Make request, retrying connection failures where allowed. Buffered
+	// streams outlive their client, so their upstream is not cancelled with it
+	resp, done, err := p.do(c, path, p.timeout, streamID != "", func(req *http.Request) {
+		// Ensure SSE headers
+		// MCP requires both application/json and text/event-stream
+		if !strings.Contains(req.Header.Get("Accept"), "text/event-stream") {
+			currentAccept := req.Header.Get("Accept")
+			if currentAccept == "" {
+				req.Header.Set("Accept", "application/json, text/event-stream")
+			} else {
+				req.Header.Set("Accept", currentAccept+", text/event-stream")
+			}
 		}
-	}
-	req.Header.Set("Cache-Control", "no-cache")
-	req.Header.Set("Connection", "keep-alive")
+		req.Header.Set("Cache-Control", "no-cache")
+		req.Header.Set("Connection", "keep-alive")
 
-	// Create HTTP client with longer timeout for SSE
-	client := &http.Client{
-		Timeout: p.timeout,
-	}
-
-	// Make request
-	resp, err := client.Do(req)
+		// Let the upstream resume after the last event the client has seen
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+	})
 	if err != nil {
 		p.logger.Error("Failed to proxy SSE request", "error", err)
-		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to reach target service"})
+		p.writeUpstreamError(c, err)
 		return
 	}
-	defer resp.Body.Close()
+	drained := false
+	defer func() {
+		if !drained {
+			done()
+			resp.Body.Close()
+		}
+	}()
 
 	// Check if response is SSE
 	contentType := resp.Header.Get("Content-Type")
@@ -131,104 +200,506 @@ func (p *SSEProxy) proxySSE(c *gin.Context) {
 		return
 	}
 
+	p.startStream(c, resp.StatusCode)
+	stats.Replayed = int64(len(replayed))
+	if !p.writeEvents(c, replayed, stats) {
+		return
+	}
+
+	// Stream SSE response
+	reader := newSSEReader(resp.Body, p.maxEventSize)
+	defer func() {
+		if !drained {
+			reader.close()
+		}
+	}()
+	switch p.streamEvents(c, reader, streamID, stats) {
+	case streamCompleted:
+		stats.Completed = true
+		if streamID != "" {
+			p.replay.complete(streamID)
+		}
+	case streamDisconnected:
+		stats.Disconnected = true
+		if streamID != "" {
+			// Keep buffering so the client can resume; the upstream is only
+			// cancelled if nobody resumes within the replay TTL
+			drained = true
+			p.logger.Info("Client disconnected, buffering upstream stream for resumption",
+				"target", p.targetURL,
+				"path", path,
+				"request_id", c.GetString("request_id"),
+				"replay_ttl", p.replay.ttl,
+			)
+			go p.drainToReplay(streamID, reader, p.cancelRequest(c, resp.Request.URL), func() {
+				reader.close()
+				done()
+				resp.Body.Close()
+			})
+			return
+		}
+
+		// Stop the upstream right away instead of letting it generate into the void
+		done()
+		p.logger.Info("Client disconnected, cancelled upstream stream",
+			"target", p.targetURL,
+			"path", path,
+			"request_id", c.GetString("request_id"),
+		)
+		if send := p.cancelRequest(c, resp.Request.URL); send != nil {
+			go send()
+		}
+	}
+}
+
+// drainToReplay keeps reading the upstream of an abandoned stream into the
+// replay buffer. It stops when the upstream ends, when a client takes the
+// stream over, or once the replay TTL has passed, in which case the upstream
+// is cancelled and the cancel endpoint, if any, is notified
+func (p *SSEProxy) drainToReplay(streamID string, reader *sseReader, notify func(), release func()) {
+	d := p.replay.drain(streamID)
+	defer p.replay.finish(streamID, d)
+	defer release()
+
+	expired := time.NewTimer(p.replay.ttl)
+	defer expired.Stop()
+
+	for {
+		select {
+		case event, ok := <-reader.events:
+			if !ok {
+				if err := <-reader.err; err != nil {
+					p.logger.Warn("Buffered SSE stream failed", "error", err)
+					return
+				}
+				p.replay.complete(streamID)
+				p.logger.Debug("Buffered SSE stream completed")
+				return
+			}
+			p.replay.append(streamID, event)
+		case <-d.stop:
+			return
+		case <-expired.C:
+			p.logger.Info("Abandoned SSE stream not resumed, cancelled upstream stream", "replay_ttl", p.replay.ttl)
+			if notify != nil {
+				go notify()
+			}
+			return
+		}
+	}
+}
+
+// cancelRequest prepares the call to the service's cancel endpoint, so the
+// backend stops work for an abandoned stream. It returns nil when no cancel
+// endpoint is configured; the returned function may run after the request
+// has finished
+func (p *SSEProxy) cancelRequest(c *gin.Context, upstream *url.URL) func() {
+	if p.cancel.Path == "" {
+		return nil
+	}
+
+	params := map[string]string{"request_id": c.GetString("request_id")}
+	if p.streamIDHeader != "" {
+		params["stream_id"] = c.GetHeader(p.streamIDHeader)
+	}
+	if routeParams, ok := c.Get("route_params"); ok {
+		if values, ok := routeParams.(map[string]string); ok {
+			for name, value := range values {
+				params[name] = value
+			}
+		}
+	}
+
+	path := expandTemplate(p.cancel.Path, params)
+	if strings.ContainsAny(path, "{}") || strings.Contains(path, "//") {
+		p.logger.Warn("Cannot resolve cancel endpoint for stream", "template", p.cancel.Path, "path", path)
+		return nil
+	}
+
+	base := upstream.Scheme + "://" + upstream.Host
+	method := p.cancel.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	timeout := p.cancel.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	// Carry the caller's credentials and correlation headers
+	header := http.Header{}
+	names := []string{"Authorization", "X-API-Key", "X-Request-ID", "X-User-ID", "X-Organization-ID"}
+	if p.identityHeader != "" {
+		names = append(names, p.identityHeader)
+	}
+	for _, name := range names {
+		if value := c.GetHeader(name); value != "" {
+			header.Set(name, value)
+		}
+	}
+	if requestID := c.GetString("request_id"); requestID != "" {
+		header.Set("X-Request-ID", requestID)
+	}
+	header.Set("X-Cancel-Reason", "client_disconnected")
+
+	client := p.client
+	if p.clients != nil {
+		client = p.clients(base)
+	}
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, method, base+path, nil)
+		if err != nil {
+			p.logger.Error("Failed to build cancel request", "url", base+path, "error", err)
+			return
+		}
+		req.Header = header
+
+		resp, err := client.Do(req)
+		if err != nil {
+			p.logger.Warn("Upstream cancel request failed", "url", base+path, "error", err)
+			return
+		}
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+
+		p.logger.Info("Notified upstream of abandoned stream", "url", base+path, "status", resp.StatusCode)
+	}
+}
+
+// finishStream logs and reports the counters of a finished stream
+func (p *SSEProxy) finishStream(stats *StreamStats) {
+	p.logger.Info("SSE stream finished",
+		"target", stats.Target,
+		"path", stats.Path,
+		"events", stats.Events,
+		"replayed", stats.Replayed,
+		"bytes", stats.Bytes,
+		"duration", stats.Duration,
+		"completed", stats.Completed,
+		"disconnected", stats.Disconnected,
+	)
+	if p.observeStream != nil {
+		p.observeStream(*stats)
+	}
+}
+
+// streamID returns the replay buffer key of the request, or "" when replay
+// is disabled or the client did not name its stream. Keys are scoped to the
+// authenticated user so streams can't be resumed across identities; anonymous
+// requests, e.g. on routes without auth_required, are never buffered since
+// anyone knowing the stream id could resume them
+func (p *SSEProxy) streamID(c *gin.Context) string {
+	if p.replay == nil || p.streamIDHeader == "" {
+		return ""
+	}
+	id := c.GetHeader(p.streamIDHeader)
+	userID := c.GetString("user_id")
+	if id == "" || userID == "" {
+		return ""
+	}
+	return userID + "|" + c.Request.URL.Path + "|" + id
+}
+
+// startStream writes the SSE response headers
+func (p *SSEProxy) startStream(c *gin.Context, status int) {
 	// Set SSE headers for client
 	c.Header("Content-Type", "text/event-stream")
 	c.Header("Cache-Control", "no-cache")
 	c.Header("Connection", "keep-alive")
 	c.Header("X-Accel-Buffering", "no") // Disable nginx buffering
-	
+
+	// Streams outlive the server write timeout; the stream deadline applies instead
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		p.logger.Warn("Failed to clear write deadline for SSE stream", "error", err)
+	}
+
 	// Set the status code from upstream response
-	c.Status(resp.StatusCode)
+	c.Status(status)
+	c.Writer.WriteHeaderNow()
+	c.Writer.Flush()
+}
 
-	// Stream SSE response
-	c.Stream(func(w io.Writer) bool {
-		scanner := bufio.NewScanner(resp.Body)
-		for scanner.Scan() {
-			line := scanner.Text()
-			
-			// Write line with newline
-			if _, err := fmt.Fprintf(w, "%s\n", line); err != nil {
-				p.logger.Error("Failed to write SSE data", "error", err)
-				return false
+// writeEvents writes complete events to the client and flushes them
+func (p *SSEProxy) writeEvents(c *gin.Context, events []sseEvent, stats *StreamStats) bool {
+	for _, event := range events {
+		n, err := c.Writer.Write(event.raw)
+		stats.Bytes += int64(n)
+		if err != nil {
+			p.logger.Error("Failed to write SSE data", "error", err)
+			return false
+		}
+		if !event.comment {
+			stats.Events++
+		}
+	}
+	c.Writer.Flush()
+	return true
+}
+
+// streamEnd describes why a relayed stream ended
+type streamEnd int
+
+const (
+	streamCompleted    streamEnd = iota // the upstream ended the stream cleanly
+	streamFailed                        // the upstream failed or timed out
+	streamDisconnected                  // the client went away
+)
+
+// sseReader parses events from an upstream body in the background
+type sseReader struct {
+	events    <-chan sseEvent
+	err       <-chan error // the read error, or nil, once events is closed
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+func newSSEReader(body io.Reader, maxEventSize int) *sseReader {
+	events := make(chan sseEvent)
+	readErr := make(chan error, 1)
+	r := &sseReader{events: events, err: readErr, stop: make(chan struct{})}
+	go func() {
+		readErr <- readSSEEvents(newSSEParser(body, maxEventSize), events, r.stop)
+		close(events)
+	}()
+	return r
+}
+
+// close stops the reader once it has parsed its current event
+func (r *sseReader) close() {
+	r.closeOnce.Do(func() { close(r.stop) })
+}
+
+// streamEvents relays events from the upstream, sending keepalive comments
+// while the upstream is quiet, and reports how the stream ended. Buffered
+// streams record every event before it is written, so an event the client
+// missed while going away can still be resumed from
+func (p *SSEProxy) streamEvents(c *gin.Context, reader *sseReader, streamID string, stats *StreamStats) streamEnd {
+	var keepalive <-chan time.Time
+	if p.keepalive > 0 {
+		ticker := time.NewTicker(p.keepalive)
+		defer ticker.Stop()
+		keepalive = ticker.C
+	}
+
+	for {
+		select {
+		case event, ok := <-reader.events:
+			if !ok {
+				if err := <-reader.err; err != nil {
+					if c.Request.Context().Err() != nil {
+						// The upstream read was cancelled along with the client request
+						return streamDisconnected
+					}
+					p.logger.Error("SSE read error", "error", err)
+					return streamFailed
+				}
+				return streamCompleted
+			}
+			if streamID != "" {
+				p.replay.append(streamID, event)
 			}
-			
-			// Flush after each event (double newline)
-			if line == "" {
-				c.Writer.Flush()
+			if !p.writeEvents(c, []sseEvent{event}, stats) {
+				return streamDisconnected
 			}
+		case <-keepalive:
+			n, err := io.WriteString(c.Writer, ": keepalive\n\n")
+			stats.Bytes += int64(n)
+			if err != nil {
+				p.logger.Debug("Client went away during keepalive", "error", err)
+				return streamDisconnected
+			}
+			c.Writer.Flush()
+		case <-c.Request.Context().Done():
+			return streamDisconnected
 		}
-		
-		if err := scanner.Err(); err != nil && err != io.EOF {
-			p.logger.Error("SSE scanner error", "error", err)
+	}
+}
+
+// readSSEEvents sends parsed events on events until the body ends or stop is closed
+func readSSEEvents(parser *sseParser, events chan<- sseEvent, stop <-chan struct{}) error {
+	for {
+		event, err := parser.next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
 		}
-		
-		return false // End stream
-	})
+		select {
+		case events <- event:
+		case <-stop:
+			return nil
+		}
+	}
 }
 
 // proxyHTTP handles regular HTTP proxying
 func (p *SSEProxy) proxyHTTP(c *gin.Context) {
-	// For agents and models services, preserve the full path as they expect /api/v1/{service}/ prefix
+	// The route table has already applied any strip/rewrite rule
 	path := c.Request.URL.Path
 	
-	// Check if this is an agents or models service that needs full path preservation
-	if strings.HasPrefix(path, "/api/v1/agents/") || strings.HasPrefix(path, "/api/v1/models/") {
-		// Keep the full path including /api/v1/{service}/
-		// These services handle their own routing with the full prefix
-	} else if strings.HasPrefix(path, "/api/v1/") {
-		// For other services, strip the /api/v1/{service} prefix
-		parts := strings.SplitN(strings.TrimPrefix(path, "/api/v1/"), "/", 2)
-		if len(parts) > 1 {
-			// Keep everything after the service name
-			path = "/" + parts[1]
-		} else {
-			// Just the service name, no additional path
-			path = "/"
-		}
-	}
-	
-	// Build target URL
-	targetURL := p.targetURL + path
-	if c.Request.URL.RawQuery != "" {
-		targetURL += "?" + c.Request.URL.RawQuery
-	}
-
 	p.logger.Debug("Proxying HTTP request",
-		"target", targetURL,
+		"target", p.targetURL+path,
 		"method", c.Request.Method,
 		"path", c.Request.URL.Path,
 	)
 
-	// Create request
-	req, err := http.NewRequestWithContext(c.Request.Context(), c.Request.Method, targetURL, c.Request.Body)
+	// Make request
+	resp, done, err := p.do(c, path, p.requestTimeout, false, nil)
 	if err != nil {
-		p.logger.Error("Failed to create proxy request", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create proxy request"})
+		p.logger.Error("Failed to proxy request", "error", err)
+		p.writeUpstreamError(c, err)
 		return
 	}
+	defer done()
+	defer resp.Body.Close()
 
-	// Copy headers
-	for key, values := range c.Request.Header {
-		if isHopByHopHeader(key) {
-			continue
+	// Copy response
+	p.copyResponse(c, resp)
+}
+
+// do sends the request upstream under the given deadline. Idempotent requests
+// are retried with backoff on connection errors and transient statuses, moving
+// to another instance when a failover hook is set. A detached request is not
+// cancelled when the client goes away. The returned function must be called
+// once the response has been consumed
+func (p *SSEProxy) do(c *gin.Context, path string, timeout time.Duration, detached bool, prepare func(*http.Request)) (*http.Response, func(), error) {
+	parent := c.Request.Context()
+	if detached {
+		parent = context.WithoutCancel(parent)
+	}
+	ctx, cancel := context.WithTimeout(parent, timeout)
+
+	p.retry.budget.recordRequest()
+	retryable := isIdempotent(c.Request.Method) && p.retry.maxAttempts > 1
+	if retryable {
+		replayable, err := bufferBody(c.Request)
+		if err != nil {
+			cancel()
+			return nil, nil, err
 		}
-		for _, value := range values {
-			req.Header.Add(key, value)
+		retryable = replayable
+	}
+
+	target := p.targetURL
+	release := func() {}
+	for attempt := 1; ; attempt++ {
+		delay := p.retry.delay(attempt)
+		final := !retryable || !p.retry.canRetry(ctx, attempt, delay)
+
+		if attempt > 1 {
+			if err := rewindBody(c.Request); err != nil {
+				release()
+				cancel()
+				return nil, nil, err
+			}
+		}
+
+		// Build target URL
+		targetURL := target + path
+		if c.Request.URL.RawQuery != "" {
+			targetURL += "?" + c.Request.URL.RawQuery
+		}
+
+		req, err := http.NewRequestWithContext(ctx, c.Request.Method, targetURL, c.Request.Body)
+		if err != nil {
+			release()
+			cancel()
+			return nil, nil, fmt.Errorf("failed to create proxy request: %w", err)
+		}
+		req.ContentLength = c.Request.ContentLength
+
+		// Copy headers
+		for key, values := range c.Request.Header {
+			// Skip hop-by-hop headers
+			if isHopByHopHeader(key) {
+				continue
+			}
+			for _, value := range values {
+				req.Header.Add(key, value)
+			}
+		}
+		// Forward the request ID so the upstream can correlate cancellation
+		if requestID := c.GetString("request_id"); requestID != "" {
+			req.Header.Set("X-Request-ID", requestID)
+		}
+		if prepare != nil {
+			prepare(req)
+		}
+
+		client := p.client
+		if p.clients != nil {
+			client = p.clients(target)
+		}
+
+		start := time.Now()
+		resp, err := client.Do(req)
+		if p.observe != nil {
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			p.observe(target, status, err, time.Since(start))
+		}
+		if final || (err == nil && !isRetryableStatus(resp.StatusCode)) {
+			if err != nil {
+				release()
+				cancel()
+				return nil, nil, err
+			}
+			finalRelease := release
+			return resp, func() {
+				finalRelease()
+				cancel()
+			}, nil
+		}
+
+		// Transient failure with attempts left: discard and try again
+		if err == nil {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+			err = fmt.Errorf("upstream returned retryable status %d", resp.StatusCode)
+		}
+		release()
+		release = func() {}
+
+		p.retry.budget.spend()
+		p.logger.Warn("Retrying upstream request",
+			"target", target,
+			"attempt", attempt,
+			"backoff", delay,
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+
+		if !sleepContext(ctx, delay) {
+			cancel()
+			return nil, nil, ctx.Err()
+		}
+
+		if p.failover != nil {
+			next, nextRelease, err := p.failover()
+			if err != nil {
+				p.logger.Warn("No failover instance available, retrying same target", "error", err)
+			} else {
+				target = next
+				release = nextRelease
+			}
 		}
 	}
+}
 
-	// Make request
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		p.logger.Error("Failed to proxy request", "error", err)
-		c.JSON(http.StatusBadGateway, gin.H{"error": "failed to reach target service"})
+// writeUpstreamError reports a failed upstream call to the client
+func (p *SSEProxy) writeUpstreamError(c *gin.Context, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		c.JSON(http.StatusGatewayTimeout, gin.H{"error": "target service timed out"})
 		return
 	}
-	defer resp.Body.Close()
-
-	// Copy response
-	p.copyResponse(c, resp)
+	c.JSON(http.StatusBadGateway, gin.H{"error": "failed to reach target service"})
 }
 
 // copyResponse copies HTTP response to client
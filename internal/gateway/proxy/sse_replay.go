@@ -0,0 +1,188 @@
+package proxy
+
+import (
+	"sync"
+	"time"
+)
+
+// replayBuffer holds the most recent events of one stream
+type replayBuffer struct {
+	events    []sseEvent
+	bytes     int  // size of the raw events held
+	completed bool // the upstream finished the stream cleanly
+	updatedAt time.Time
+}
+
+// replayStore keeps the last events of recent streams so a reconnecting
+// client can resume from its Last-Event-ID without the upstream replaying.
+// Only events that carry an id are buffered, since only those can be resumed
+// from. Each stream holds at most capacity events and maxBytes bytes; the
+// oldest events are dropped first
+type replayStore struct {
+	mu         sync.Mutex
+	capacity   int
+	maxBytes   int
+	ttl        time.Duration
+	maxStreams int
+	streams    map[string]*replayBuffer
+
+	// Upstreams still being read into the buffer after their client left
+	drains map[string]*replayDrain
+}
+
+// replayDrain is an abandoned stream whose upstream is still being buffered
+type replayDrain struct {
+	stop    chan struct{} // closed when a client takes the stream over
+	stopped chan struct{} // closed once the drain has finished
+}
+
+func newReplayStore(capacity, maxBytes, maxStreams int, ttl time.Duration) *replayStore {
+	if maxBytes <= 0 {
+		maxBytes = 1 << 20
+	}
+	if maxStreams <= 0 {
+		maxStreams = 1000
+	}
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+	return &replayStore{
+		capacity:   capacity,
+		maxBytes:   maxBytes,
+		ttl:        ttl,
+		maxStreams: maxStreams,
+		streams:    make(map[string]*replayBuffer),
+		drains:     make(map[string]*replayDrain),
+	}
+}
+
+// drain registers the upstream of an abandoned stream as being buffered. The
+// drain must call finish once it stops reading
+func (s *replayStore) drain(streamID string) *replayDrain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := &replayDrain{stop: make(chan struct{}), stopped: make(chan struct{})}
+	s.drains[streamID] = d
+	return d
+}
+
+// finish unregisters a drain once it has stopped reading its upstream
+func (s *replayStore) finish(streamID string, d *replayDrain) {
+	s.mu.Lock()
+	if s.drains[streamID] == d {
+		delete(s.drains, streamID)
+	}
+	s.mu.Unlock()
+	close(d.stopped)
+}
+
+// takeOver stops the drain of a stream a client is requesting again and
+// waits until it has buffered its last event
+func (s *replayStore) takeOver(streamID string) {
+	s.mu.Lock()
+	d, exists := s.drains[streamID]
+	if exists {
+		delete(s.drains, streamID)
+	}
+	s.mu.Unlock()
+	if exists {
+		close(d.stop)
+		<-d.stopped
+	}
+}
+
+// reset starts a fresh buffer for a stream that is not being resumed
+func (s *replayStore) reset(streamID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.streams[streamID] = &replayBuffer{updatedAt: time.Now()}
+	s.evict()
+}
+
+// append records an event of a stream
+func (s *replayStore) append(streamID string, event sseEvent) {
+	if event.id == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buffer, exists := s.streams[streamID]
+	if !exists {
+		buffer = &replayBuffer{}
+		s.streams[streamID] = buffer
+		s.evict()
+	}
+	buffer.events = append(buffer.events, event)
+	buffer.bytes += len(event.raw)
+	drop := 0
+	for len(buffer.events)-drop > s.capacity || (buffer.bytes > s.maxBytes && drop < len(buffer.events)) {
+		buffer.bytes -= len(buffer.events[drop].raw)
+		drop++
+	}
+	if drop > 0 {
+		buffer.events = append(buffer.events[:0:0], buffer.events[drop:]...)
+	}
+	buffer.completed = false
+	buffer.updatedAt = time.Now()
+}
+
+// complete marks a stream as cleanly finished by the upstream
+func (s *replayStore) complete(streamID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if buffer, exists := s.streams[streamID]; exists {
+		buffer.completed = true
+		buffer.updatedAt = time.Now()
+	}
+}
+
+// since returns the buffered events that follow lastEventID. found is false
+// when the id is no longer (or was never) in the buffer
+func (s *replayStore) since(streamID, lastEventID string) (events []sseEvent, completed, found bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buffer, exists := s.streams[streamID]
+	if !exists {
+		return nil, false, false
+	}
+	if time.Since(buffer.updatedAt) > s.ttl {
+		delete(s.streams, streamID)
+		return nil, false, false
+	}
+
+	for i := len(buffer.events) - 1; i >= 0; i-- {
+		if buffer.events[i].id == lastEventID {
+			events = append(events, buffer.events[i+1:]...)
+			return events, buffer.completed, true
+		}
+	}
+	return nil, false, false
+}
+
+// evict drops expired streams and, if still over the limit, the least
+// recently updated ones. Callers hold s.mu
+func (s *replayStore) evict() {
+	if len(s.streams) <= s.maxStreams {
+		return
+	}
+
+	now := time.Now()
+	for id, buffer := range s.streams {
+		if now.Sub(buffer.updatedAt) > s.ttl {
+			delete(s.streams, id)
+		}
+	}
+	for len(s.streams) > s.maxStreams {
+		var oldestID string
+		var oldest time.Time
+		for id, buffer := range s.streams {
+			if oldestID == "" || buffer.updatedAt.Before(oldest) {
+				oldestID, oldest = id, buffer.updatedAt
+			}
+		}
+		delete(s.streams, oldestID)
+	}
+}
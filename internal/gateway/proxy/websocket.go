@@ -0,0 +1,314 @@
+package proxy
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/internal/gateway/tracing"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+// webSocketHandshakeHeaders are generated by the dialer and must not be
+// copied from the client request
+var webSocketHandshakeHeaders = map[string]bool{
+	"Sec-Websocket-Key":        true,
+	"Sec-Websocket-Version":    true,
+	"Sec-Websocket-Extensions": true,
+	"Sec-Websocket-Protocol":   true,
+}
+
+// WebSocketProxy relays WebSocket connections between clients and upstream instances
+type WebSocketProxy struct {
+	config   config.WebSocketConfig
+	logger   *logger.Logger
+	dialer   *websocket.Dialer
+	upgrader *websocket.Upgrader
+}
+
+// NewWebSocketProxy creates a WebSocket proxy. Browser origins are checked
+// against allowedOrigins, where "*" allows any origin
+func NewWebSocketProxy(cfg config.WebSocketConfig, allowedOrigins []string, logger *logger.Logger) *WebSocketProxy {
+	if cfg.HandshakeTimeout <= 0 {
+		cfg.HandshakeTimeout = 10 * time.Second
+	}
+	if cfg.WriteTimeout <= 0 {
+		cfg.WriteTimeout = 10 * time.Second
+	}
+	if cfg.MaxMessageSize <= 0 {
+		cfg.MaxMessageSize = 1 << 20
+	}
+
+	p := &WebSocketProxy{
+		config: cfg,
+		logger: logger,
+		dialer: &websocket.Dialer{
+			NetDialContext:   (&net.Dialer{Timeout: cfg.HandshakeTimeout, KeepAlive: 30 * time.Second}).DialContext,
+			HandshakeTimeout: cfg.HandshakeTimeout,
+		},
+	}
+	p.upgrader = &websocket.Upgrader{
+		HandshakeTimeout: cfg.HandshakeTimeout,
+		CheckOrigin:      originChecker(allowedOrigins),
+	}
+	return p
+}
+
+// originChecker accepts requests without an Origin header (non-browser
+// clients), same-host origins and the configured origins
+func originChecker(allowedOrigins []string) func(*http.Request) bool {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || allowed["*"] || allowed[strings.ToLower(origin)] {
+			return true
+		}
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		return strings.EqualFold(u.Host, r.Host)
+	}
+}
+
+// isWebSocketRequest reports whether the request asks for a WebSocket upgrade
+func isWebSocketRequest(r *http.Request) bool {
+	return websocket.IsWebSocketUpgrade(r)
+}
+
+// Serve dials the upstream at baseURL, upgrades the client connection and
+// relays frames until either side closes or the connection goes idle. The
+// returned outcome describes the upstream handshake
+func (p *WebSocketProxy) Serve(c *gin.Context, baseURL string) attemptOutcome {
+	target, err := url.Parse(baseURL)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": "invalid upstream address"})
+		return attemptOutcome{skipped: true}
+	}
+	switch target.Scheme {
+	case "https":
+		target.Scheme = "wss"
+	default:
+		target.Scheme = "ws"
+	}
+	target.Path = c.Request.URL.Path
+	target.RawQuery = c.Request.URL.RawQuery
+
+	// Reject disallowed origins before dialing; the upstream is not involved
+	if !p.upgrader.CheckOrigin(c.Request) {
+		p.logger.Warn("Rejected WebSocket from disallowed origin", "origin", c.GetHeader("Origin"))
+		c.JSON(http.StatusForbidden, gin.H{"error": "origin not allowed"})
+		return attemptOutcome{skipped: true}
+	}
+
+	// Dial upstream first so failures can still be reported as plain HTTP errors
+	start := time.Now()
+	upstream, resp, err := p.dialer.DialContext(c.Request.Context(), target.String(), p.upstreamHeaders(c.Request))
+	latency := time.Since(start)
+	if err != nil {
+		if resp != nil {
+			p.logger.Warn("Upstream rejected WebSocket handshake",
+				"target", target.String(),
+				"status", resp.StatusCode,
+			)
+			status := resp.StatusCode
+			if status < 400 {
+				status = http.StatusBadGateway
+			}
+			c.JSON(status, gin.H{"error": "upstream rejected WebSocket upgrade"})
+			return attemptOutcome{success: status < http.StatusInternalServerError, latency: latency}
+		}
+		p.logger.Error("Failed to dial upstream WebSocket", "target", target.String(), "error", err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Service unavailable"})
+		return attemptOutcome{latency: latency}
+	}
+	defer upstream.Close()
+
+	// Accept the client with the subprotocol the upstream selected
+	responseHeader := http.Header{}
+	if protocol := upstream.Subprotocol(); protocol != "" {
+		responseHeader.Set("Sec-WebSocket-Protocol", protocol)
+	}
+	client, err := p.upgrader.Upgrade(c.Writer, c.Request, responseHeader)
+	if err != nil {
+		// The upgrader has already written an HTTP error to the client.
+		// The client failed its handshake, which says nothing about the upstream
+		p.logger.Warn("Failed to upgrade client WebSocket", "error", err)
+		upstream.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseGoingAway, ""),
+			time.Now().Add(p.config.WriteTimeout))
+		return attemptOutcome{clientError: true, latency: latency}
+	}
+	defer client.Close()
+
+	p.logger.Info("WebSocket connection established",
+		"target", target.String(),
+		"subprotocol", upstream.Subprotocol(),
+	)
+
+	p.relay(client, upstream)
+	return attemptOutcome{success: true, latency: latency}
+}
+
+// upstreamHeaders copies end-to-end request headers for the upstream handshake
+func (p *WebSocketProxy) upstreamHeaders(r *http.Request) http.Header {
+	header := http.Header{}
+	for key, values := range r.Header {
+		canonical := http.CanonicalHeaderKey(key)
+		if isHopByHopHeader(canonical) || webSocketHandshakeHeaders[canonical] {
+			continue
+		}
+		for _, value := range values {
+			header.Add(canonical, value)
+		}
+	}
+
+	// The dialer sets Sec-WebSocket-Protocol from the requested subprotocols
+	for _, protocol := range websocket.Subprotocols(r) {
+		header.Add("Sec-WebSocket-Protocol", protocol)
+	}
+
+	if clientIP, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		if prior := header.Get("X-Forwarded-For"); prior != "" {
+			clientIP = prior + ", " + clientIP
+		}
+		header.Set("X-Forwarded-For", clientIP)
+	}
+	header.Set("X-Forwarded-Host", r.Host)
+	tracing.InjectHeader(r.Context(), header)
+	return header
+}
+
+// relay copies frames in both directions. When one side closes, the close
+// code is passed on to the other side before both connections are torn down
+func (p *WebSocketProxy) relay(client, upstream *websocket.Conn) {
+	var lastActivity atomic.Int64
+	touch := func() { lastActivity.Store(time.Now().UnixNano()) }
+	touch()
+
+	client.SetReadLimit(p.config.MaxMessageSize)
+	upstream.SetReadLimit(p.config.MaxMessageSize)
+	p.relayControl(client, upstream, touch)
+	p.relayControl(upstream, client, touch)
+
+	done := make(chan struct{})
+	var closeOnce sync.Once
+	shutdown := func(code int, reason string) {
+		closeOnce.Do(func() {
+			deadline := time.Now().Add(p.config.WriteTimeout)
+			message := websocket.FormatCloseMessage(code, reason)
+			client.WriteControl(websocket.CloseMessage, message, deadline)
+			upstream.WriteControl(websocket.CloseMessage, message, deadline)
+			close(done)
+		})
+	}
+
+	if p.config.IdleTimeout > 0 {
+		go func() {
+			ticker := time.NewTicker(p.config.IdleTimeout / 4)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					if time.Since(time.Unix(0, lastActivity.Load())) >= p.config.IdleTimeout {
+						p.logger.Info("Closing idle WebSocket connection", "idle_timeout", p.config.IdleTimeout)
+						shutdown(websocket.CloseGoingAway, "idle timeout")
+						client.Close()
+						upstream.Close()
+						return
+					}
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	errs := make(chan error, 2)
+	go func() { errs <- p.copyFrames(upstream, client, touch) }()
+	go func() { errs <- p.copyFrames(client, upstream, touch) }()
+
+	err := <-errs
+	code, reason := closeCodeFor(err)
+	shutdown(code, reason)
+
+	// Give the other side a moment to acknowledge the close before tearing down
+	select {
+	case <-errs:
+	case <-time.After(p.config.WriteTimeout):
+	}
+
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		p.logger.Debug("WebSocket connection closed", "code", closeErr.Code, "reason", closeErr.Text)
+	} else {
+		p.logger.Debug("WebSocket connection ended", "error", err)
+	}
+}
+
+// relayControl forwards pings and pongs read from src to dst
+func (p *WebSocketProxy) relayControl(src, dst *websocket.Conn, touch func()) {
+	src.SetPingHandler(func(data string) error {
+		touch()
+		return p.writeControl(dst, websocket.PingMessage, data)
+	})
+	src.SetPongHandler(func(data string) error {
+		touch()
+		return p.writeControl(dst, websocket.PongMessage, data)
+	})
+	// Close frames are propagated by relay once the read loop returns
+	src.SetCloseHandler(func(int, string) error { return nil })
+}
+
+func (p *WebSocketProxy) writeControl(conn *websocket.Conn, messageType int, data string) error {
+	err := conn.WriteControl(messageType, []byte(data), time.Now().Add(p.config.WriteTimeout))
+	if errors.Is(err, websocket.ErrCloseSent) {
+		return nil
+	}
+	return err
+}
+
+// copyFrames copies data messages from src to dst until src fails or closes
+func (p *WebSocketProxy) copyFrames(dst, src *websocket.Conn, touch func()) error {
+	for {
+		messageType, data, err := src.ReadMessage()
+		if err != nil {
+			return err
+		}
+		touch()
+
+		dst.SetWriteDeadline(time.Now().Add(p.config.WriteTimeout))
+		if err := dst.WriteMessage(messageType, data); err != nil {
+			return err
+		}
+	}
+}
+
+// closeCodeFor maps the error that ended a relay to the close frame sent to the peers
+func closeCodeFor(err error) (int, string) {
+	var closeErr *websocket.CloseError
+	switch {
+	case errors.As(err, &closeErr):
+		switch closeErr.Code {
+		case websocket.CloseNoStatusReceived, websocket.CloseAbnormalClosure, websocket.CloseTLSHandshake:
+			// Reserved codes that must not be sent on the wire
+			return websocket.CloseGoingAway, ""
+		}
+		return closeErr.Code, closeErr.Text
+	case errors.Is(err, websocket.ErrReadLimit):
+		return websocket.CloseMessageTooBig, "message too big"
+	}
+	return websocket.CloseGoingAway, ""
+}
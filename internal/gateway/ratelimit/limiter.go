@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Decision is the outcome of taking a token
+type Decision struct {
+	Allowed    bool
+	Limit      int           // bucket size
+	Remaining  int           // tokens left after this request
+	Reset      time.Duration // until the bucket is full again
+	RetryAfter time.Duration // until a token is available, when not allowed
+}
+
+// Limiter takes tokens from per-key buckets
+type Limiter interface {
+	// Allow takes a token from the bucket of key sized to limit. An error
+	// means the decision could not be made
+	Allow(ctx context.Context, key string, limit Limit) (Decision, error)
+
+	// Stats describes the limiter for health reporting
+	Stats() map[string]interface{}
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// LocalLimiter keeps a token bucket per key in memory. Buckets idle for
+// longer than the idle TTL are evicted
+type LocalLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	idleTTL   time.Duration
+	lastSweep time.Time
+}
+
+// NewLocalLimiter creates an in-memory limiter
+func NewLocalLimiter(idleTTL time.Duration) *LocalLimiter {
+	if idleTTL <= 0 {
+		idleTTL = 10 * time.Minute
+	}
+	return &LocalLimiter{buckets: make(map[string]*bucket), idleTTL: idleTTL}
+}
+
+// Allow takes a token from the bucket of key, creating or resizing the
+// bucket to limit as needed. It never fails
+func (l *LocalLimiter) Allow(ctx context.Context, key string, limit Limit) (Decision, error) {
+	return l.allow(key, limit), nil
+}
+
+func (l *LocalLimiter) allow(key string, limit Limit) Decision {
+	now := time.Now()
+
+	l.mu.Lock()
+	if now.Sub(l.lastSweep) > l.idleTTL/2 {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) > l.idleTTL {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	b, exists := l.buckets[key]
+	if !exists {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RPS), limit.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	l.mu.Unlock()
+
+	// The tier of a caller may change between requests
+	if b.limiter.Limit() != rate.Limit(limit.RPS) {
+		b.limiter.SetLimitAt(now, rate.Limit(limit.RPS))
+	}
+	if b.limiter.Burst() != limit.Burst {
+		b.limiter.SetBurstAt(now, limit.Burst)
+	}
+
+	decision := Decision{Limit: limit.Burst}
+	reservation := b.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 || !reservation.OK() {
+		reservation.CancelAt(now)
+		decision.RetryAfter = delay
+	} else {
+		decision.Allowed = true
+	}
+
+	tokens := b.limiter.TokensAt(now)
+	decision.Remaining = int(math.Max(0, math.Floor(tokens)))
+	decision.Reset = time.Duration((float64(limit.Burst) - tokens) / float64(limit.RPS) * float64(time.Second))
+	return decision
+}
+
+// Stats reports the number of live buckets
+func (l *LocalLimiter) Stats() map[string]interface{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return map[string]interface{}{
+		"backend": "memory",
+		"buckets": len(l.buckets),
+	}
+}
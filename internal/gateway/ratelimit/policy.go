@@ -0,0 +1,158 @@
+// Package ratelimit limits requests per caller with token buckets. Limits are
+// resolved per route and per subscription tier
+package ratelimit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+)
+
+// Ways to key a bucket
+const (
+	KeyByIdentity = "identity" // API key or user, falling back to the client IP
+	KeyByOrg      = "org"      // organization, falling back to identity
+	KeyByIP       = "ip"
+)
+
+// Limit is a bucket size and refill rate
+type Limit struct {
+	RPS   int
+	Burst int
+}
+
+// Rule is the limit applied to a request
+type Rule struct {
+	Name  string // route prefix, or "default"
+	KeyBy string
+	Limit Limit
+}
+
+type routeLimit struct {
+	prefix string
+	limit  Limit
+	keyBy  string
+	tiers  map[string]Limit
+}
+
+// Policy resolves the limit for a request
+type Policy struct {
+	defaults Limit
+	keyBy    string
+	tiers    map[string]Limit
+	routes   []routeLimit // longest prefix first
+}
+
+// NewPolicy validates the rate limit configuration
+func NewPolicy(cfg config.RateLimitConfig) (*Policy, error) {
+	p := &Policy{
+		defaults: Limit{RPS: cfg.RPS, Burst: cfg.Burst},
+		keyBy:    cfg.KeyBy,
+	}
+	if p.keyBy == "" {
+		p.keyBy = KeyByIdentity
+	}
+	if err := checkKeyBy(p.keyBy); err != nil {
+		return nil, err
+	}
+	if err := checkLimit("default", p.defaults); err != nil {
+		return nil, err
+	}
+
+	tiers, err := tierLimits("tier", cfg.Tiers)
+	if err != nil {
+		return nil, err
+	}
+	p.tiers = tiers
+
+	seen := make(map[string]bool, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		if !strings.HasPrefix(route.PathPrefix, "/") {
+			return nil, fmt.Errorf("rate limit path_prefix %q must start with /", route.PathPrefix)
+		}
+		if seen[route.PathPrefix] {
+			return nil, fmt.Errorf("duplicate rate limit for %s", route.PathPrefix)
+		}
+		seen[route.PathPrefix] = true
+
+		limit := Limit{RPS: route.RPS, Burst: route.Burst}
+		if limit.RPS != 0 || limit.Burst != 0 {
+			if err := checkLimit(route.PathPrefix, limit); err != nil {
+				return nil, err
+			}
+		}
+		keyBy := route.KeyBy
+		if keyBy == "" {
+			keyBy = p.keyBy
+		}
+		if err := checkKeyBy(keyBy); err != nil {
+			return nil, fmt.Errorf("rate limit for %s: %w", route.PathPrefix, err)
+		}
+		tiers, err := tierLimits(route.PathPrefix+" tier", route.Tiers)
+		if err != nil {
+			return nil, err
+		}
+		p.routes = append(p.routes, routeLimit{prefix: route.PathPrefix, limit: limit, keyBy: keyBy, tiers: tiers})
+	}
+	sort.SliceStable(p.routes, func(i, j int) bool {
+		return len(p.routes[i].prefix) > len(p.routes[j].prefix)
+	})
+	return p, nil
+}
+
+func checkKeyBy(keyBy string) error {
+	switch keyBy {
+	case KeyByIdentity, KeyByOrg, KeyByIP:
+		return nil
+	}
+	return fmt.Errorf("unsupported rate limit key_by %q", keyBy)
+}
+
+func checkLimit(name string, limit Limit) error {
+	if limit.RPS <= 0 || limit.Burst <= 0 {
+		return fmt.Errorf("rate limit %s needs a positive rps and burst", name)
+	}
+	return nil
+}
+
+func tierLimits(name string, values map[string]config.RateLimitValues) (map[string]Limit, error) {
+	tiers := make(map[string]Limit, len(values))
+	for tier, value := range values {
+		limit := Limit{RPS: value.RPS, Burst: value.Burst}
+		if err := checkLimit(name+" "+tier, limit); err != nil {
+			return nil, err
+		}
+		tiers[strings.ToLower(tier)] = limit
+	}
+	return tiers, nil
+}
+
+// Resolve returns the rule for a path and subscription tier. A route's tier
+// limit wins over its own limit, which wins over the global tier limit
+func (p *Policy) Resolve(path, tier string) Rule {
+	tier = strings.ToLower(tier)
+	for _, route := range p.routes {
+		if !strings.HasPrefix(path, route.prefix) {
+			continue
+		}
+		rule := Rule{Name: route.prefix, KeyBy: route.keyBy}
+		if limit, exists := route.tiers[tier]; exists {
+			rule.Limit = limit
+		} else if route.limit.RPS > 0 {
+			rule.Limit = route.limit
+		} else {
+			rule.Limit = p.tierLimit(tier)
+		}
+		return rule
+	}
+	return Rule{Name: "default", KeyBy: p.keyBy, Limit: p.tierLimit(tier)}
+}
+
+func (p *Policy) tierLimit(tier string) Limit {
+	if limit, exists := p.tiers[tier]; exists {
+		return limit
+	}
+	return p.defaults
+}
@@ -0,0 +1,190 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+// gcraScript implements the generic cell rate algorithm. A bucket is a single
+// key holding its theoretical arrival time (TAT) in microseconds of Redis
+// server time, so every replica shares one clock.
+//
+//	ARGV[1] emission interval in microseconds (1s / rps)
+//	ARGV[2] burst
+//
+// It returns {allowed, microseconds until the bucket is full, microseconds
+// until a token is available}
+var gcraScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+  tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - interval * burst
+if allow_at > now then
+  return {0, tat - now, allow_at - now}
+end
+
+redis.call('SET', KEYS[1], new_tat, 'PX', math.ceil((new_tat - now) / 1000) + 1)
+return {1, new_tat - now, 0}
+`)
+
+// RedisLimiter shares buckets between gateway replicas through Redis
+type RedisLimiter struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisLimiter creates a limiter on the configured Redis
+func NewRedisLimiter(cfg config.RedisConfig, prefix string) *RedisLimiter {
+	return &RedisLimiter{
+		client: redis.NewClient(&redis.Options{
+			Addr:         fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+			Password:     cfg.Password,
+			DB:           cfg.Database,
+			DialTimeout:  time.Second,
+			ReadTimeout:  200 * time.Millisecond,
+			WriteTimeout: 200 * time.Millisecond,
+			MaxRetries:   -1, // the fallback limiter handles failures
+		}),
+		prefix: prefix,
+	}
+}
+
+// Allow takes a token from the shared bucket of key
+func (l *RedisLimiter) Allow(ctx context.Context, key string, limit Limit) (Decision, error) {
+	interval := time.Second.Microseconds() / int64(limit.RPS)
+	if interval < 1 {
+		interval = 1
+	}
+
+	result, err := gcraScript.Run(ctx, l.client, []string{l.prefix + key}, interval, limit.Burst).Int64Slice()
+	if err != nil {
+		return Decision{}, err
+	}
+	if len(result) != 3 {
+		return Decision{}, fmt.Errorf("unexpected rate limit script result %v", result)
+	}
+
+	untilFull := time.Duration(result[1]) * time.Microsecond
+	remaining := limit.Burst - int((result[1]+interval-1)/interval)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return Decision{
+		Allowed:    result[0] == 1,
+		Limit:      limit.Burst,
+		Remaining:  remaining,
+		Reset:      untilFull,
+		RetryAfter: time.Duration(result[2]) * time.Microsecond,
+	}, nil
+}
+
+// Stats reports the backend
+func (l *RedisLimiter) Stats() map[string]interface{} {
+	return map[string]interface{}{"backend": "redis"}
+}
+
+// Close closes the Redis connection pool
+func (l *RedisLimiter) Close() error {
+	return l.client.Close()
+}
+
+// FallbackLimiter uses a shared limiter and falls back to local buckets while
+// it fails. After a failure the shared limiter is left alone for a cooldown
+// so an unreachable Redis does not add latency to every request
+type FallbackLimiter struct {
+	primary  Limiter
+	local    *LocalLimiter
+	cooldown time.Duration
+	logger   *logger.Logger
+
+	mu        sync.Mutex
+	downUntil time.Time
+	lastError error
+
+	fallbacks atomic.Int64
+}
+
+// NewFallbackLimiter wraps primary with local as the fallback
+func NewFallbackLimiter(primary Limiter, local *LocalLimiter, cooldown time.Duration, logger *logger.Logger) *FallbackLimiter {
+	if cooldown <= 0 {
+		cooldown = 5 * time.Second
+	}
+	return &FallbackLimiter{primary: primary, local: local, cooldown: cooldown, logger: logger}
+}
+
+// Allow asks the shared limiter, or the local one while it is unavailable
+func (l *FallbackLimiter) Allow(ctx context.Context, key string, limit Limit) (Decision, error) {
+	now := time.Now()
+	l.mu.Lock()
+	down := now.Before(l.downUntil)
+	l.mu.Unlock()
+
+	if !down {
+		decision, err := l.primary.Allow(ctx, key, limit)
+		if err == nil {
+			l.recovered()
+			return decision, nil
+		}
+		l.failed(now, err)
+	}
+
+	l.fallbacks.Add(1)
+	return l.local.allow(key, limit), nil
+}
+
+func (l *FallbackLimiter) failed(now time.Time, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.lastError == nil {
+		l.logger.Warn("Shared rate limiter unavailable, limiting per replica", "error", err, "retry_in", l.cooldown)
+	}
+	l.downUntil = now.Add(l.cooldown)
+	l.lastError = err
+}
+
+func (l *FallbackLimiter) recovered() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.lastError != nil {
+		l.logger.Info("Shared rate limiter recovered")
+		l.lastError = nil
+	}
+}
+
+// Stats reports the shared backend, its state and the local fallback
+func (l *FallbackLimiter) Stats() map[string]interface{} {
+	stats := l.primary.Stats()
+	l.mu.Lock()
+	stats["healthy"] = l.lastError == nil
+	if l.lastError != nil {
+		stats["last_error"] = l.lastError.Error()
+	}
+	l.mu.Unlock()
+	stats["fallback_decisions"] = l.fallbacks.Load()
+	stats["local"] = l.local.Stats()
+	return stats
+}
+
+// Close closes the shared limiter if it holds connections
+func (l *FallbackLimiter) Close() error {
+	if closer, ok := l.primary.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+// startRedis runs an in-process Redis with its clock frozen at the returned
+// time, so GCRA results are exact
+func startRedis(t *testing.T) (*miniredis.Miniredis, time.Time) {
+	t.Helper()
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	t.Cleanup(server.Close)
+
+	now := time.Unix(1700000000, 0)
+	server.SetTime(now)
+	return server, now
+}
+
+func newTestRedisLimiter(t *testing.T, server *miniredis.Miniredis) *RedisLimiter {
+	t.Helper()
+	port, err := strconv.Atoi(server.Port())
+	if err != nil {
+		t.Fatalf("invalid miniredis port %q: %v", server.Port(), err)
+	}
+	limiter := NewRedisLimiter(config.RedisConfig{Host: server.Host(), Port: port}, "test:")
+	t.Cleanup(func() { limiter.Close() })
+	return limiter
+}
+
+func mustAllow(t *testing.T, limiter Limiter, key string, limit Limit) Decision {
+	t.Helper()
+	decision, err := limiter.Allow(context.Background(), key, limit)
+	if err != nil {
+		t.Fatalf("Allow(%s) failed: %v", key, err)
+	}
+	return decision
+}
+
+func TestRedisLimiterGCRA(t *testing.T) {
+	server, now := startRedis(t)
+	limiter := newTestRedisLimiter(t, server)
+	limit := Limit{RPS: 10, Burst: 3} // one token every 100ms
+
+	tests := []struct {
+		allowed    bool
+		remaining  int
+		reset      time.Duration
+		retryAfter time.Duration
+	}{
+		{true, 2, 100 * time.Millisecond, 0},
+		{true, 1, 200 * time.Millisecond, 0},
+		{true, 0, 300 * time.Millisecond, 0},
+		{false, 0, 300 * time.Millisecond, 100 * time.Millisecond},
+		{false, 0, 300 * time.Millisecond, 100 * time.Millisecond},
+	}
+	for i, want := range tests {
+		got := mustAllow(t, limiter, "user:1", limit)
+		if got.Allowed != want.allowed || got.Remaining != want.remaining ||
+			got.Reset != want.reset || got.RetryAfter != want.retryAfter || got.Limit != limit.Burst {
+			t.Errorf("request %d: got %+v, want allowed=%v remaining=%d reset=%v retry_after=%v limit=%d",
+				i+1, got, want.allowed, want.remaining, want.reset, want.retryAfter, limit.Burst)
+		}
+	}
+
+	// One emission interval later exactly one token is back
+	server.SetTime(now.Add(100 * time.Millisecond))
+	if got := mustAllow(t, limiter, "user:1", limit); !got.Allowed || got.Remaining != 0 {
+		t.Errorf("after refill: got %+v, want one allowed request", got)
+	}
+	if got := mustAllow(t, limiter, "user:1", limit); got.Allowed {
+		t.Errorf("after refill: got %+v, want the bucket empty again", got)
+	}
+
+	// Other keys have their own bucket
+	if got := mustAllow(t, limiter, "user:2", limit); !got.Allowed || got.Remaining != 2 {
+		t.Errorf("other key: got %+v, want a full bucket", got)
+	}
+}
+
+func TestRedisLimiterSharedBuckets(t *testing.T) {
+	server, _ := startRedis(t)
+	replicaA := newTestRedisLimiter(t, server)
+	replicaB := newTestRedisLimiter(t, server)
+	limit := Limit{RPS: 1, Burst: 3}
+
+	mustAllow(t, replicaA, "org:acme", limit)
+	mustAllow(t, replicaB, "org:acme", limit)
+	if got := mustAllow(t, replicaA, "org:acme", limit); !got.Allowed || got.Remaining != 0 {
+		t.Fatalf("third request: got %+v, want the last token", got)
+	}
+
+	for name, replica := range map[string]*RedisLimiter{"A": replicaA, "B": replicaB} {
+		if got := mustAllow(t, replica, "org:acme", limit); got.Allowed {
+			t.Errorf("replica %s: got %+v, want the shared bucket empty", name, got)
+		}
+	}
+}
+
+func TestFallbackLimiterOutageAndRecovery(t *testing.T) {
+	server, _ := startRedis(t)
+	cooldown := 200 * time.Millisecond
+	limiter := NewFallbackLimiter(newTestRedisLimiter(t, server), NewLocalLimiter(time.Minute), cooldown, logger.New("error", false))
+	limit := Limit{RPS: 1, Burst: 2}
+
+	// Healthy: decisions come from Redis
+	mustAllow(t, limiter, "user:1", limit)
+	if server.CommandCount() == 0 {
+		t.Fatal("expected the first decision to be made by Redis")
+	}
+	if healthy := limiter.Stats()["healthy"]; healthy != true {
+		t.Fatalf("healthy = %v before the outage", healthy)
+	}
+
+	// Redis goes away: the local bucket decides and the error is swallowed
+	server.Close()
+	if got := mustAllow(t, limiter, "user:1", limit); !got.Allowed || got.Remaining != 1 {
+		t.Errorf("first fallback decision: got %+v, want a fresh local bucket", got)
+	}
+	stats := limiter.Stats()
+	if stats["healthy"] != false || stats["last_error"] == nil || stats["fallback_decisions"] != int64(1) {
+		t.Errorf("stats during outage = %v", stats)
+	}
+
+	// Within the cooldown Redis is not asked even once it is back
+	if err := server.Restart(); err != nil {
+		t.Fatalf("failed to restart miniredis: %v", err)
+	}
+	commands := server.CommandCount()
+	mustAllow(t, limiter, "user:1", limit)
+	if got := mustAllow(t, limiter, "user:1", limit); got.Allowed {
+		t.Errorf("local bucket: got %+v, want it empty after the burst", got)
+	}
+	if server.CommandCount() != commands {
+		t.Errorf("Redis received %d commands during the cooldown", server.CommandCount()-commands)
+	}
+	if fallbacks := limiter.Stats()["fallback_decisions"]; fallbacks != int64(3) {
+		t.Errorf("fallback_decisions = %v, want 3", fallbacks)
+	}
+
+	// After the cooldown Redis decides again, from the bucket it kept
+	time.Sleep(cooldown + 50*time.Millisecond)
+	if got := mustAllow(t, limiter, "user:1", limit); !got.Allowed || got.Remaining != 0 {
+		t.Errorf("after recovery: got %+v, want the second token of the Redis bucket", got)
+	}
+	if server.CommandCount() == commands {
+		t.Error("expected Redis to be asked after the cooldown")
+	}
+	stats = limiter.Stats()
+	if stats["healthy"] != true || stats["last_error"] != nil || stats["fallback_decisions"] != int64(3) {
+		t.Errorf("stats after recovery = %v", stats)
+	}
+}
@@ -0,0 +1,234 @@
+package registry
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+)
+
+// Load balancing strategy names
+const (
+	StrategyRoundRobin   = "round_robin"
+	StrategyLeastRequest = "least_request"
+	StrategyWeighted     = "weighted"
+	StrategyP2C          = "p2c"
+)
+
+// Balancer selects one instance out of a set of healthy candidates
+type Balancer interface {
+	Pick(instances []*ServiceInstance) *ServiceInstance
+}
+
+// NewBalancer creates a balancer for the given strategy
+func NewBalancer(strategy string, tracker *InFlightTracker) (Balancer, error) {
+	switch strategy {
+	case "", StrategyRoundRobin:
+		return &roundRobinBalancer{}, nil
+	case StrategyLeastRequest:
+		return &leastRequestBalancer{tracker: tracker}, nil
+	case StrategyWeighted:
+		return &weightedBalancer{current: make(map[string]int)}, nil
+	case StrategyP2C:
+		return &p2cBalancer{tracker: tracker}, nil
+	default:
+		return nil, fmt.Errorf("unknown load balancing strategy: %s", strategy)
+	}
+}
+
+// roundRobinBalancer cycles through instances in order
+type roundRobinBalancer struct {
+	next uint64
+}
+
+func (b *roundRobinBalancer) Pick(instances []*ServiceInstance) *ServiceInstance {
+	if len(instances) == 0 {
+		return nil
+	}
+	n := atomic.AddUint64(&b.next, 1) - 1
+	return instances[n%uint64(len(instances))]
+}
+
+// leastRequestBalancer picks the instance with the fewest in-flight requests,
+// scaled by instance weight
+type leastRequestBalancer struct {
+	tracker *InFlightTracker
+	next    uint64
+}
+
+func (b *leastRequestBalancer) Pick(instances []*ServiceInstance) *ServiceInstance {
+	if len(instances) == 0 {
+		return nil
+	}
+
+	// Start from a rotating offset so ties are spread across instances
+	offset := atomic.AddUint64(&b.next, 1) - 1
+	var best *ServiceInstance
+	var bestLoad float64
+	for i := range instances {
+		instance := instances[(offset+uint64(i))%uint64(len(instances))]
+		load := float64(b.tracker.Load(instance.ID)) / float64(instance.weight())
+		if best == nil || load < bestLoad {
+			best = instance
+			bestLoad = load
+		}
+	}
+	return best
+}
+
+// weightedBalancer implements smooth weighted round-robin using the
+// instance weight published in Consul service meta
+type weightedBalancer struct {
+	mu      sync.Mutex
+	current map[string]int
+}
+
+func (b *weightedBalancer) Pick(instances []*ServiceInstance) *ServiceInstance {
+	if len(instances) == 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	total := 0
+	var best *ServiceInstance
+	for _, instance := range instances {
+		weight := instance.weight()
+		total += weight
+		b.current[instance.ID] += weight
+		if best == nil || b.current[instance.ID] > b.current[best.ID] {
+			best = instance
+		}
+	}
+	b.current[best.ID] -= total
+
+	// Forget instances that are no longer part of the candidate set
+	if len(b.current) > len(instances) {
+		present := make(map[string]bool, len(instances))
+		for _, instance := range instances {
+			present[instance.ID] = true
+		}
+		for id := range b.current {
+			if !present[id] {
+				delete(b.current, id)
+			}
+		}
+	}
+
+	return best
+}
+
+// p2cBalancer samples two random instances and picks the less loaded one
+type p2cBalancer struct {
+	tracker *InFlightTracker
+}
+
+func (b *p2cBalancer) Pick(instances []*ServiceInstance) *ServiceInstance {
+	switch len(instances) {
+	case 0:
+		return nil
+	case 1:
+		return instances[0]
+	}
+
+	i := rand.Intn(len(instances))
+	j := rand.Intn(len(instances) - 1)
+	if j >= i {
+		j++
+	}
+
+	a, c := instances[i], instances[j]
+	loadA := float64(b.tracker.Load(a.ID)) / float64(a.weight())
+	loadC := float64(b.tracker.Load(c.ID)) / float64(c.weight())
+	if loadC < loadA {
+		return c
+	}
+	return a
+}
+
+// InFlightTracker counts requests the gateway currently has outstanding per instance
+type InFlightTracker struct {
+	mu     sync.RWMutex
+	counts map[string]*inFlightCount
+}
+
+// inFlightCount is the in-flight request count of one instance
+type inFlightCount struct {
+	service string
+	n       int64
+}
+
+// NewInFlightTracker creates an empty in-flight tracker
+func NewInFlightTracker() *InFlightTracker {
+	return &InFlightTracker{
+		counts: make(map[string]*inFlightCount),
+	}
+}
+
+// Begin marks a request to the instance as started and returns a function
+// that must be called once it completes
+func (t *InFlightTracker) Begin(service, instanceID string) func() {
+	counter := t.counter(service, instanceID)
+	atomic.AddInt64(&counter.n, 1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			atomic.AddInt64(&counter.n, -1)
+		})
+	}
+}
+
+// Load returns the number of in-flight requests for the instance
+func (t *InFlightTracker) Load(instanceID string) int64 {
+	t.mu.RLock()
+	counter, exists := t.counts[instanceID]
+	t.mu.RUnlock()
+	if !exists {
+		return 0
+	}
+	return atomic.LoadInt64(&counter.n)
+}
+
+// Snapshot returns the current in-flight count of every known instance
+func (t *InFlightTracker) Snapshot() map[string]int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	snapshot := make(map[string]int64, len(t.counts))
+	for id, counter := range t.counts {
+		snapshot[id] = atomic.LoadInt64(&counter.n)
+	}
+	return snapshot
+}
+
+// Forget drops the counters of the service's instances that are not in
+// present. Requests still running on them complete against the dropped counter
+func (t *InFlightTracker) Forget(service string, present map[string]bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for id, counter := range t.counts {
+		if counter.service == service && !present[id] {
+			delete(t.counts, id)
+		}
+	}
+}
+
+func (t *InFlightTracker) counter(service, instanceID string) *inFlightCount {
+	t.mu.RLock()
+	counter, exists := t.counts[instanceID]
+	t.mu.RUnlock()
+	if exists {
+		return counter
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if counter, exists = t.counts[instanceID]; !exists {
+		counter = &inFlightCount{service: service}
+		t.counts[instanceID] = counter
+	}
+	return counter
+}
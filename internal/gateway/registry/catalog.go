@@ -0,0 +1,326 @@
+package registry
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// catalogEntry is the locally cached view of one service
+type catalogEntry struct {
+	instances []*ServiceInstance
+	index     uint64
+	updatedAt time.Time
+	lastError error
+	ready     chan struct{} // closed once the first fetch attempt has finished
+	lastUsed  atomic.Int64  // unix nanoseconds of the last lookup
+	watching  bool          // a watch loop is running; guarded by catalogMu
+}
+
+// serviceList is the locally cached set of service names in the Consul
+// catalog, used to tell real services from arbitrary names in request paths
+type serviceList struct {
+	names     map[string]bool
+	ready     chan struct{} // closed once the first fetch attempt has finished
+	lastError error
+}
+
+// CatalogStatus describes the cached state of a watched service
+type CatalogStatus struct {
+	Instances int       `json:"instances"`
+	Index     uint64    `json:"index"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Stale     bool      `json:"stale"`
+	LastError string    `json:"last_error,omitempty"`
+}
+
+// ConfigureCatalog sets the blocking query wait time, the retry interval used
+// while Consul is unreachable, how long a first lookup waits for data and how
+// long a service watch is kept without lookups
+func (r *ConsulRegistry) ConfigureCatalog(waitTime, retryInterval, initialFetchTimeout, idleTimeout time.Duration) {
+	r.catalogMu.Lock()
+	defer r.catalogMu.Unlock()
+
+	if waitTime > 0 {
+		r.watchWaitTime = waitTime
+	}
+	if retryInterval > 0 {
+		r.watchRetryInterval = retryInterval
+	}
+	if initialFetchTimeout > 0 {
+		r.initialFetchTimeout = initialFetchTimeout
+	}
+	if idleTimeout > 0 {
+		r.watchIdleTimeout = idleTimeout
+	}
+}
+
+// HasService reports whether the Consul catalog has a service called name.
+// The list of names is watched like the instances of a service; while Consul
+// is unreachable the last known list is used
+func (r *ConsulRegistry) HasService(name string) bool {
+	r.servicesOnce.Do(func() {
+		r.services = &serviceList{ready: make(chan struct{})}
+		r.watchers.Add(1)
+		go r.servicesLoop()
+	})
+
+	select {
+	case <-r.services.ready:
+	case <-time.After(r.initialFetchTimeout):
+		return false
+	}
+
+	r.catalogMu.RLock()
+	defer r.catalogMu.RUnlock()
+	return r.services.names[name]
+}
+
+// servicesLoop keeps the list of service names fresh using blocking queries
+func (r *ConsulRegistry) servicesLoop() {
+	defer r.watchers.Done()
+
+	firstAttempt := true
+	markReady := func() {
+		if firstAttempt {
+			close(r.services.ready)
+			firstAttempt = false
+		}
+	}
+	defer markReady()
+
+	var index uint64
+	for {
+		r.catalogMu.RLock()
+		waitTime := r.watchWaitTime
+		retryInterval := r.watchRetryInterval
+		r.catalogMu.RUnlock()
+
+		opts := (&api.QueryOptions{WaitIndex: index, WaitTime: waitTime}).WithContext(r.watchCtx)
+		services, meta, err := r.client.Catalog().Services(opts)
+		if err != nil {
+			if r.watchCtx.Err() != nil {
+				return
+			}
+
+			r.catalogMu.Lock()
+			r.services.lastError = err
+			r.catalogMu.Unlock()
+			markReady()
+
+			r.logger.Warn("Consul service list watch failed, using last known services", "error", err)
+
+			select {
+			case <-time.After(retryInterval):
+			case <-r.watchCtx.Done():
+				return
+			}
+			continue
+		}
+
+		if meta.LastIndex < index {
+			index = 0
+			continue
+		}
+		if meta.LastIndex == index && !firstAttempt {
+			continue
+		}
+		index = meta.LastIndex
+
+		names := make(map[string]bool, len(services))
+		for name := range services {
+			names[name] = true
+		}
+
+		r.catalogMu.Lock()
+		r.services.names = names
+		r.services.lastError = nil
+		r.catalogMu.Unlock()
+		markReady()
+	}
+}
+
+// cachedInstances returns the healthy instances of a service from the local
+// catalog, starting a watch on first use
+func (r *ConsulRegistry) cachedInstances(name string) ([]*ServiceInstance, error) {
+	entry := r.ensureWatch(name)
+
+	select {
+	case <-entry.ready:
+	case <-time.After(r.initialFetchTimeout):
+		return nil, fmt.Errorf("timed out waiting for catalog data for service: %s", name)
+	}
+
+	r.catalogMu.RLock()
+	instances := entry.instances
+	lastError := entry.lastError
+	r.catalogMu.RUnlock()
+
+	if len(instances) == 0 {
+		if lastError != nil {
+			return nil, fmt.Errorf("failed to discover service: %w", lastError)
+		}
+		return nil, fmt.Errorf("no healthy instances found for service: %s", name)
+	}
+	return instances, nil
+}
+
+// ensureWatch returns the catalog entry for a service, starting its watch
+// loop if needed. A stopped watch is restarted on the existing entry, which
+// keeps serving the last known instances until the new watch has data
+func (r *ConsulRegistry) ensureWatch(name string) *catalogEntry {
+	r.catalogMu.RLock()
+	entry, exists := r.catalog[name]
+	watching := exists && entry.watching
+	r.catalogMu.RUnlock()
+	if watching {
+		entry.lastUsed.Store(time.Now().UnixNano())
+		return entry
+	}
+
+	r.catalogMu.Lock()
+	defer r.catalogMu.Unlock()
+	if entry, exists = r.catalog[name]; !exists {
+		entry = &catalogEntry{ready: make(chan struct{})}
+		r.catalog[name] = entry
+	}
+	entry.lastUsed.Store(time.Now().UnixNano())
+	if entry.watching {
+		return entry
+	}
+	entry.watching = true
+
+	r.watchers.Add(1)
+	go r.watchLoop(name, entry)
+
+	r.logger.Debug("Started catalog watch", "service", name)
+	return entry
+}
+
+// watchLoop keeps a catalog entry fresh using Consul blocking queries. On
+// errors the last known instances are kept so the gateway can keep serving.
+// The watch ends once the service has no subscribers and has not been
+// looked up for the idle timeout; the next lookup starts a new one
+func (r *ConsulRegistry) watchLoop(name string, entry *catalogEntry) {
+	defer r.watchers.Done()
+
+	firstAttempt := true
+	markReady := func() {
+		if firstAttempt {
+			firstAttempt = false
+			select {
+			case <-entry.ready:
+				// Restarted watch; the entry has served lookups before
+			default:
+				close(entry.ready)
+			}
+		}
+	}
+	defer markReady()
+
+	var index uint64
+	for {
+		r.catalogMu.RLock()
+		waitTime := r.watchWaitTime
+		retryInterval := r.watchRetryInterval
+		idleTimeout := r.watchIdleTimeout
+		r.catalogMu.RUnlock()
+
+		if time.Since(time.Unix(0, entry.lastUsed.Load())) > idleTimeout {
+			r.catalogMu.Lock()
+			idle := len(r.subscribers[name]) == 0
+			if idle {
+				entry.watching = false
+			}
+			r.catalogMu.Unlock()
+			if idle {
+				r.logger.Debug("Stopped idle catalog watch", "service", name)
+				return
+			}
+		}
+
+		opts := (&api.QueryOptions{WaitIndex: index, WaitTime: waitTime}).WithContext(r.watchCtx)
+		services, meta, err := r.client.Health().Service(name, "", true, opts)
+		if err != nil {
+			if r.watchCtx.Err() != nil {
+				return
+			}
+
+			r.catalogMu.Lock()
+			entry.lastError = err
+			cached := len(entry.instances)
+			r.catalogMu.Unlock()
+			markReady()
+
+			r.logger.Warn("Consul catalog watch failed, serving last known instances",
+				"service", name,
+				"cached_instances", cached,
+				"error", err,
+			)
+
+			select {
+			case <-time.After(retryInterval):
+			case <-r.watchCtx.Done():
+				return
+			}
+			continue
+		}
+
+		// Consul may reset its index; start over rather than block forever
+		if meta.LastIndex < index {
+			index = 0
+			continue
+		}
+		if meta.LastIndex == index && !firstAttempt {
+			// Blocking query timed out without changes
+			continue
+		}
+		index = meta.LastIndex
+
+		instances := r.toInstances(services)
+
+		r.catalogMu.Lock()
+		entry.instances = instances
+		entry.index = index
+		entry.updatedAt = time.Now()
+		entry.lastError = nil
+		callbacks := append([]func([]*ServiceInstance){}, r.subscribers[name]...)
+		r.catalogMu.Unlock()
+		markReady()
+
+		r.logger.Debug("Catalog updated", "service", name, "instances", len(instances), "index", index)
+
+		for _, callback := range callbacks {
+			callback(instances)
+		}
+	}
+}
+
+// CatalogStatus returns the cached state of every watched service
+func (r *ConsulRegistry) CatalogStatus() map[string]CatalogStatus {
+	r.catalogMu.RLock()
+	defer r.catalogMu.RUnlock()
+
+	status := make(map[string]CatalogStatus, len(r.catalog))
+	for name, entry := range r.catalog {
+		s := CatalogStatus{
+			Instances: len(entry.instances),
+			Index:     entry.index,
+			UpdatedAt: entry.updatedAt,
+			Stale:     entry.lastError != nil,
+		}
+		if entry.lastError != nil {
+			s.LastError = entry.lastError.Error()
+		}
+		status[name] = s
+	}
+	return status
+}
+
+// Close stops all catalog watches
+func (r *ConsulRegistry) Close() {
+	r.stopWatches()
+	r.watchers.Wait()
+}
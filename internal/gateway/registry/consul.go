@@ -1,8 +1,11 @@
 package registry
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/consul/api"
@@ -13,6 +16,28 @@ import (
 type ConsulRegistry struct {
 	client *api.Client
 	logger *logger.Logger
+
+	// Load balancing
+	mu              sync.RWMutex
+	balancers       map[string]Balancer
+	defaultStrategy string
+	strategies      map[string]string
+	weightMetaKey   string
+	inFlight        *InFlightTracker
+
+	// Local service catalog kept fresh by blocking queries
+	catalogMu           sync.RWMutex
+	catalog             map[string]*catalogEntry
+	subscribers         map[string][]func([]*ServiceInstance) // kept across idle watch restarts
+	services            *serviceList
+	servicesOnce        sync.Once
+	watchWaitTime       time.Duration
+	watchRetryInterval  time.Duration
+	initialFetchTimeout time.Duration
+	watchIdleTimeout    time.Duration
+	watchCtx            context.Context
+	stopWatches         context.CancelFunc
+	watchers            sync.WaitGroup
 }
 
 // NewConsulRegistry creates a new Consul-based service registry
@@ -27,12 +52,64 @@ func NewConsulRegistry(address string, logger *logger.Logger) (*ConsulRegistry,
 		return nil, fmt.Errorf("failed to create consul client: %w", err)
 	}
 	
+	watchCtx, stopWatches := context.WithCancel(context.Background())
+	
 	return &ConsulRegistry{
-		client: client,
-		logger: logger,
+		client:          client,
+		logger:          logger,
+		balancers:       make(map[string]Balancer),
+		defaultStrategy: StrategyRoundRobin,
+		strategies:      make(map[string]string),
+		weightMetaKey:   "weight",
+		inFlight:        NewInFlightTracker(),
+
+		catalog:             make(map[string]*catalogEntry),
+		subscribers:         make(map[string][]func([]*ServiceInstance)),
+		watchWaitTime:       5 * time.Minute,
+		watchRetryInterval:  2 * time.Second,
+		initialFetchTimeout: 5 * time.Second,
+		watchIdleTimeout:    10 * time.Minute,
+		watchCtx:            watchCtx,
+		stopWatches:         stopWatches,
 	}, nil
 }
 
+// ConfigureLoadBalancing sets the default strategy, per-service overrides and
+// the Consul service meta key that carries instance weights
+func (r *ConsulRegistry) ConfigureLoadBalancing(defaultStrategy string, perService map[string]string, weightMetaKey string) error {
+	// Validate every strategy before applying anything
+	if _, err := NewBalancer(defaultStrategy, r.inFlight); err != nil {
+		return err
+	}
+	for service, strategy := range perService {
+		if _, err := NewBalancer(strategy, r.inFlight); err != nil {
+			return fmt.Errorf("service %s: %w", service, err)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if defaultStrategy != "" {
+		r.defaultStrategy = defaultStrategy
+	}
+	r.strategies = make(map[string]string, len(perService))
+	for service, strategy := range perService {
+		r.strategies[service] = strategy
+	}
+	if weightMetaKey != "" {
+		r.weightMetaKey = weightMetaKey
+	}
+	// Drop existing balancers so the new strategies take effect
+	r.balancers = make(map[string]Balancer)
+
+	r.logger.Info("Load balancing configured",
+		"default_strategy", r.defaultStrategy,
+		"overrides", r.strategies,
+	)
+	return nil
+}
+
 // RegisterService registers a service with Consul
 func (r *ConsulRegistry) RegisterService(name string, host string, port int, tags []string) error {
 	serviceID := fmt.Sprintf("%s-%s-%d", name, host, port)
@@ -89,36 +166,151 @@ func (r *ConsulRegistry) DiscoverService(name string) ([]*ServiceInstance, error
 		return nil, fmt.Errorf("no healthy instances found for service: %s", name)
 	}
 	
+	return r.toInstances(services), nil
+}
+
+// toInstances converts Consul health entries into service instances
+func (r *ConsulRegistry) toInstances(services []*api.ServiceEntry) []*ServiceInstance {
 	instances := make([]*ServiceInstance, 0, len(services))
 	for _, service := range services {
 		instance := &ServiceInstance{
-			ID:   service.Service.ID,
-			Name: service.Service.Service,
-			Host: service.Service.Address,
-			Port: service.Service.Port,
-			Tags: service.Service.Tags,
+			ID:     service.Service.ID,
+			Name:   service.Service.Service,
+			Host:   service.Service.Address,
+			Port:   service.Service.Port,
+			Tags:   service.Service.Tags,
+			Meta:   service.Service.Meta,
+			Weight: r.instanceWeight(service.Service),
+		}
+		// Services registered without an address use the node address
+		if instance.Host == "" && service.Node != nil {
+			instance.Host = service.Node.Address
 		}
 		instances = append(instances, instance)
 	}
 	
-	return instances, nil
+	return instances
 }
 
-// GetHealthyInstance returns a healthy instance (with simple round-robin)
+// GetHealthyInstance returns a healthy instance from the local catalog, chosen
+// by the service's load balancing strategy
 func (r *ConsulRegistry) GetHealthyInstance(name string) (*ServiceInstance, error) {
-	instances, err := r.DiscoverService(name)
+	return r.SelectInstance(name, nil)
+}
+
+// SelectInstance returns a healthy instance, skipping any instance for which
+// exclude returns true (e.g. instances already tried by a retry)
+func (r *ConsulRegistry) SelectInstance(name string, exclude func(*ServiceInstance) bool) (*ServiceInstance, error) {
+	instances, err := r.cachedInstances(name)
 	if err != nil {
 		return nil, err
 	}
 	
-	// Simple selection - just return first healthy instance
-	// In production, you'd want proper load balancing
-	return instances[0], nil
+	if exclude != nil {
+		candidates := make([]*ServiceInstance, 0, len(instances))
+		for _, instance := range instances {
+			if !exclude(instance) {
+				candidates = append(candidates, instance)
+			}
+		}
+		instances = candidates
+	}
+	
+	instance := r.balancerFor(name).Pick(instances)
+	if instance == nil {
+		return nil, fmt.Errorf("no eligible instances found for service: %s", name)
+	}
+	return instance, nil
+}
+
+// BeginRequest records an in-flight request to the instance and returns a
+// function that must be called when the request completes
+func (r *ConsulRegistry) BeginRequest(instance *ServiceInstance) func() {
+	return r.inFlight.Begin(instance.Name, instance.ID)
 }
 
-// WatchService watches for changes in a service
+// ForgetInstances drops the in-flight counters of the service's instances
+// that are not in present, once they have left the catalog
+func (r *ConsulRegistry) ForgetInstances(service string, present map[string]bool) {
+	r.inFlight.Forget(service, present)
+}
+
+// InFlight returns the in-flight request count of every instance the gateway has used
+func (r *ConsulRegistry) InFlight() map[string]int64 {
+	return r.inFlight.Snapshot()
+}
+
+// balancerFor returns the balancer for a service, creating it on first use
+func (r *ConsulRegistry) balancerFor(name string) Balancer {
+	r.mu.RLock()
+	balancer, exists := r.balancers[name]
+	r.mu.RUnlock()
+	if exists {
+		return balancer
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if balancer, exists = r.balancers[name]; exists {
+		return balancer
+	}
+
+	strategy, exists := r.strategies[name]
+	if !exists {
+		strategy = r.defaultStrategy
+	}
+	balancer, err := NewBalancer(strategy, r.inFlight)
+	if err != nil {
+		// Strategies are validated in ConfigureLoadBalancing, so this only
+		// guards against an unexpected default
+		r.logger.Warn("Invalid load balancing strategy, using round-robin", "service", name, "strategy", strategy)
+		balancer = &roundRobinBalancer{}
+	}
+	r.balancers[name] = balancer
+	return balancer
+}
+
+// instanceWeight reads the instance weight from service meta, falling back to Consul weights
+func (r *ConsulRegistry) instanceWeight(service *api.AgentService) int {
+	r.mu.RLock()
+	metaKey := r.weightMetaKey
+	r.mu.RUnlock()
+
+	if value, exists := service.Meta[metaKey]; exists {
+		if weight, err := strconv.Atoi(value); err == nil && weight > 0 {
+			return weight
+		}
+		r.logger.Warn("Ignoring invalid instance weight", "instance", service.ID, "weight", value)
+	}
+	if service.Weights.Passing > 0 {
+		return service.Weights.Passing
+	}
+	return 1
+}
+
+// WatchService registers a callback that is invoked with the healthy
+// instances of a service every time the catalog changes
 func (r *ConsulRegistry) WatchService(name string, callback func([]*ServiceInstance)) error {
-	// Simplified implementation - in production you'd use consul watch
+	if callback == nil {
+		return fmt.Errorf("watch callback must not be nil")
+	}
+	
+	entry := r.ensureWatch(name)
+	
+	r.catalogMu.Lock()
+	r.subscribers[name] = append(r.subscribers[name], callback)
+	r.catalogMu.Unlock()
+	
+	// Deliver the current view right away if the catalog is already populated
+	select {
+	case <-entry.ready:
+		r.catalogMu.RLock()
+		instances := entry.instances
+		r.catalogMu.RUnlock()
+		callback(instances)
+	default:
+	}
+	
 	r.logger.Info("Watch service registered", "service", name)
 	return nil
 }
@@ -140,11 +332,21 @@ func (r *ConsulRegistry) ListServices() (map[string][]string, error) {
 
 // ServiceInstance represents a service instance
 type ServiceInstance struct {
-	ID   string
-	Name string
-	Host string
-	Port int
-	Tags []string
+	ID     string
+	Name   string
+	Host   string
+	Port   int
+	Tags   []string
+	Meta   map[string]string
+	Weight int
+}
+
+// weight returns the instance weight, treating unset weights as 1
+func (i *ServiceInstance) weight() int {
+	if i.Weight <= 0 {
+		return 1
+	}
+	return i.Weight
 }
 
 // CreateHTTPCheck creates an HTTP health check function
@@ -0,0 +1,147 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/internal/config"
+)
+
+// ParseClientAuth maps the configured client_auth mode to a tls.ClientAuthType.
+// Client certificates are only requested when a client CA is configured
+func ParseClientAuth(mode string, hasCA bool) (tls.ClientAuthType, error) {
+	switch strings.ToLower(mode) {
+	case "", "optional":
+		if !hasCA {
+			return tls.NoClientCert, nil
+		}
+		return tls.VerifyClientCertIfGiven, nil
+	case "require":
+		if !hasCA {
+			return tls.NoClientCert, fmt.Errorf("client_auth require needs a client_ca_file")
+		}
+		return tls.RequireAndVerifyClientCert, nil
+	case "none":
+		return tls.NoClientCert, nil
+	}
+	return tls.NoClientCert, fmt.Errorf("unsupported client_auth %q", mode)
+}
+
+// ParseMinVersion maps "1.2" or "1.3" to a TLS version, defaulting to 1.2
+func ParseMinVersion(version string) (uint16, error) {
+	switch version {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("unsupported min_version %q", version)
+}
+
+// ServerConfig returns a listener configuration whose certificate and client
+// CAs follow the reloader. nextProtos must list the ALPN protocols of the
+// listener, since the per-handshake configuration replaces the server's own
+func ServerConfig(cfg config.ServerTLSConfig, r *Reloader, nextProtos ...string) (*tls.Config, error) {
+	clientAuth, err := ParseClientAuth(cfg.ClientAuth, cfg.ClientCAFile != "")
+	if err != nil {
+		return nil, err
+	}
+	minVersion, err := ParseMinVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		if cert := r.Certificate(); cert != nil {
+			return cert, nil
+		}
+		return nil, errors.New("no server certificate configured")
+	}
+
+	return &tls.Config{
+		MinVersion: minVersion,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				MinVersion:     minVersion,
+				NextProtos:     nextProtos,
+				GetCertificate: certificate,
+				ClientAuth:     clientAuth,
+				ClientCAs:      r.CAPool(),
+			}, nil
+		},
+		// Used by servers that inspect the base configuration directly
+		GetCertificate: certificate,
+		NextProtos:     nextProtos,
+	}, nil
+}
+
+// ClientTLS builds configurations for connections to backends. The client
+// certificate and the CA bundle used to verify backends follow the reloader;
+// without a CA bundle the system roots are used
+type ClientTLS struct {
+	config   config.UpstreamTLSConfig
+	reloader *Reloader
+}
+
+// NewClientTLS creates backend TLS settings; r may be nil when neither a
+// client certificate nor a CA bundle is configured
+func NewClientTLS(cfg config.UpstreamTLSConfig, r *Reloader) *ClientTLS {
+	return &ClientTLS{config: cfg, reloader: r}
+}
+
+// Config returns the configuration for a connection to host, built from the
+// current certificate and CA bundle
+func (c *ClientTLS) Config(host string) *tls.Config {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         c.config.ServerName,
+		InsecureSkipVerify: c.config.InsecureSkipVerify,
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = host
+	}
+	if c.reloader != nil {
+		tlsConfig.RootCAs = c.reloader.CAPool()
+		if cert := c.reloader.Certificate(); cert != nil {
+			tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				return c.reloader.Certificate(), nil
+			}
+		}
+	}
+	return tlsConfig
+}
+
+// DialContext returns a dial function that completes the TLS handshake
+// itself, so every connection uses the current certificate and CA bundle
+func (c *ClientTLS) DialContext(dialer *net.Dialer, handshakeTimeout time.Duration, nextProtos ...string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		conn, err := dialer.DialContext(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig := c.Config(host)
+		tlsConfig.NextProtos = nextProtos
+		tlsConn := tls.Client(conn, tlsConfig)
+
+		if handshakeTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, handshakeTimeout)
+			defer cancel()
+		}
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return tlsConn, nil
+	}
+}
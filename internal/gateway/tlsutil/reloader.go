@@ -0,0 +1,169 @@
+// Package tlsutil builds TLS configurations for the gateway listeners and for
+// connections to backends, reloading certificates when their files change
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/isa-cloud/isa_cloud/pkg/logger"
+)
+
+// Reloader holds a certificate key pair and a CA bundle loaded from files
+// and reloads them when the files change. Either part may be unused
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	logger   *logger.Logger
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	pool     *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+// NewReloader loads the files once. A missing or invalid file is an error at
+// startup; later reload failures keep the previously loaded material
+func NewReloader(certFile, keyFile, caFile string, logger *logger.Logger) (*Reloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("certificate and key files must be configured together")
+	}
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		logger:   logger,
+		modTimes: make(map[string]time.Time),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Watch checks the files for changes every interval until ctx is done
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.load(); err != nil {
+				r.logger.Error("Failed to reload TLS material, keeping the previous version", "error", err)
+				continue
+			}
+			r.logger.Info("Reloaded TLS material", "cert_file", r.certFile, "ca_file", r.caFile)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Certificate returns the current key pair, or nil if none is configured
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// CAPool returns the current CA bundle, or nil if none is configured
+func (r *Reloader) CAPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pool
+}
+
+// Status describes the loaded certificate for health reporting
+func (r *Reloader) Status() map[string]interface{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	status := map[string]interface{}{}
+	if r.cert != nil && r.cert.Leaf != nil {
+		status["subject"] = r.cert.Leaf.Subject.String()
+		status["not_after"] = r.cert.Leaf.NotAfter
+	}
+	if r.caFile != "" {
+		status["ca_file"] = r.caFile
+	}
+	return status
+}
+
+func (r *Reloader) files() []string {
+	var files []string
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// changed reports whether any file was modified since the last load
+func (r *Reloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return fmt.Errorf("failed to stat %s: %w", file, err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		pair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load key pair %s: %w", r.certFile, err)
+		}
+		if pair.Leaf == nil && len(pair.Certificate) > 0 {
+			pair.Leaf, _ = x509.ParseCertificate(pair.Certificate[0])
+		}
+		cert = &pair
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		data, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("failed to read CA file %s: %w", r.caFile, err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificates found in CA file %s", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.pool, r.modTimes = cert, pool, modTimes
+	return nil
+}
@@ -0,0 +1,182 @@
+// Package identity carries the caller identity from the gateway to backend
+// services. After authenticating a request the gateway forwards a short-lived
+// HS256 JWT in the X-Gateway-Identity header; services verify it with the
+// shared secret instead of re-verifying the caller's token
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Header carries the signed identity unless the gateway is configured otherwise
+const Header = "X-Gateway-Identity"
+
+// Issuer is the iss claim of identities signed by the gateway
+const Issuer = "isa-cloud-gateway"
+
+// MinSecretLength is the shortest secret accepted for signing
+const MinSecretLength = 32
+
+var (
+	// ErrMissing is returned when a request carries no identity
+	ErrMissing = errors.New("request carries no gateway identity")
+
+	// ErrInvalid is returned for identities that do not verify
+	ErrInvalid = errors.New("invalid gateway identity")
+)
+
+// Claims is the identity of an authenticated caller. The subject is the user
+// ID; the JWT ID is the gateway request ID
+type Claims struct {
+	OrganizationID   string   `json:"org,omitempty"`
+	Email            string   `json:"email,omitempty"`
+	AuthMethod       string   `json:"auth_method"`
+	Scopes           []string `json:"scopes,omitempty"`
+	Permissions      []string `json:"permissions,omitempty"`
+	AccessLevel      string   `json:"access_level,omitempty"`
+	SubscriptionTier string   `json:"subscription_tier,omitempty"`
+	Service          string   `json:"service,omitempty"` // internal caller, if any
+	Internal         bool     `json:"internal,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// UserID returns the authenticated user
+func (c *Claims) UserID() string {
+	return c.Subject
+}
+
+// RequestID returns the gateway request ID the identity was issued for
+func (c *Claims) RequestID() string {
+	return c.ID
+}
+
+// Signer issues identities
+type Signer struct {
+	keyID  string
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewSigner creates a signer. keyID is sent as the kid header so services can
+// hold several secrets while one is rotated
+func NewSigner(keyID string, secret []byte, ttl time.Duration) (*Signer, error) {
+	if len(secret) < MinSecretLength {
+		return nil, fmt.Errorf("identity secret must be at least %d bytes", MinSecretLength)
+	}
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	return &Signer{keyID: keyID, secret: secret, ttl: ttl}, nil
+}
+
+// Sign returns the header value for claims. Issuer, issue and expiry times
+// are set by the signer
+func (s *Signer) Sign(claims Claims) (string, error) {
+	now := time.Now()
+	claims.Issuer = Issuer
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(s.ttl))
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
+	if s.keyID != "" {
+		token.Header["kid"] = s.keyID
+	}
+	return token.SignedString(s.secret)
+}
+
+// Verifier checks identities issued by the gateway
+type Verifier struct {
+	keys   map[string][]byte // kid -> secret; "" matches identities without a kid
+	header string
+	leeway time.Duration
+}
+
+// NewVerifier creates a verifier for the given secrets by key ID. A single
+// secret under "" accepts identities with any key ID
+func NewVerifier(keys map[string][]byte) (*Verifier, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one identity secret is required")
+	}
+	for kid, secret := range keys {
+		if len(secret) < MinSecretLength {
+			return nil, fmt.Errorf("identity secret %q must be at least %d bytes", kid, MinSecretLength)
+		}
+	}
+	return &Verifier{keys: keys, header: Header, leeway: 30 * time.Second}, nil
+}
+
+// WithHeader changes the header read by VerifyRequest and Middleware
+func (v *Verifier) WithHeader(header string) *Verifier {
+	v.header = header
+	return v
+}
+
+// Verify parses and checks a signed identity
+func (v *Verifier) Verify(value string) (*Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(value, &claims, v.key,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(v.leeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalid)
+	}
+	return &claims, nil
+}
+
+func (v *Verifier) key(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if secret, exists := v.keys[kid]; exists {
+		return secret, nil
+	}
+	if secret, exists := v.keys[""]; exists && len(v.keys) == 1 {
+		return secret, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// VerifyRequest verifies the identity carried by a request
+func (v *Verifier) VerifyRequest(r *http.Request) (*Claims, error) {
+	value := r.Header.Get(v.header)
+	if value == "" {
+		return nil, ErrMissing
+	}
+	return v.Verify(value)
+}
+
+// Middleware rejects requests without a valid identity with 401 and makes
+// the claims available through FromContext
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := v.VerifyRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), claims)))
+	})
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying claims
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, contextKey{}, claims)
+}
+
+// FromContext returns the claims stored by Middleware
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(contextKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
@@ -1,18 +1,80 @@
 package logger
 
 import (
+	"fmt"
+	"io"
 	"log/slog"
-	"os"
 	"strings"
 )
 
 // Logger wraps slog.Logger with additional functionality
 type Logger struct {
 	*slog.Logger
+	closer io.Closer
+}
+
+// Options configures the format and destination of a logger
+type Options struct {
+	Level    string
+	Format   string // json or text; empty picks text in debug mode and json otherwise
+	Output   string // stdout, file or both
+	File     string
+	Rotation Rotation
+	Debug    bool
 }
 
 // New creates a new logger with the specified level and debug mode
 func New(level string, debug bool) *Logger {
+	logger, _ := NewWithOptions(Options{Level: level, Debug: debug})
+	return logger
+}
+
+// NewWithOptions creates a logger writing in the configured format to the
+// configured output. Close the logger to release its file
+func NewWithOptions(options Options) (*Logger, error) {
+	writer, err := NewWriter(options.Output, options.File, options.Rotation)
+	if err != nil {
+		return nil, err
+	}
+
+	format := strings.ToLower(options.Format)
+	if format == "" {
+		format = "json"
+		if options.Debug {
+			format = "text"
+		}
+	}
+
+	opts := &slog.HandlerOptions{
+		Level: parseLevel(options.Level),
+	}
+
+	var handler slog.Handler
+	switch format {
+	case "text":
+		handler = slog.NewTextHandler(writer, opts)
+	case "json":
+		handler = slog.NewJSONHandler(writer, opts)
+	default:
+		writer.Close()
+		return nil, fmt.Errorf("unsupported log format %q", options.Format)
+	}
+
+	return &Logger{
+		Logger: slog.New(handler),
+		closer: writer,
+	}, nil
+}
+
+// Close flushes and closes the log file, if any
+func (l *Logger) Close() error {
+	if l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
+
+func parseLevel(level string) slog.Level {
 	var logLevel slog.Level
 	
 	switch strings.ToLower(level) {
@@ -27,23 +89,7 @@ func New(level string, debug bool) *Logger {
 	default:
 		logLevel = slog.LevelInfo
 	}
-
-	opts := &slog.HandlerOptions{
-		Level: logLevel,
-	}
-
-	var handler slog.Handler
-	if debug {
-		// Text handler for development
-		handler = slog.NewTextHandler(os.Stdout, opts)
-	} else {
-		// JSON handler for production
-		handler = slog.NewJSONHandler(os.Stdout, opts)
-	}
-
-	return &Logger{
-		Logger: slog.New(handler),
-	}
+	return logLevel
 }
 
 // WithService returns a logger with service name context
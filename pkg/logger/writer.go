@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Rotation limits the size and age of a log file. A file is rotated when it
+// reaches MaxSizeMB and, if Interval is set, at least every Interval
+type Rotation struct {
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAgeDays int
+	Interval   time.Duration
+	Compress   bool
+}
+
+// NewWriter opens a log destination: stdout, a rotating file, or both. Close
+// the writer to stop rotation and release the file
+func NewWriter(output, file string, rotation Rotation) (io.WriteCloser, error) {
+	switch output {
+	case "", "stdout":
+		return nopCloser{os.Stdout}, nil
+	case "file", "both":
+		if file == "" {
+			return nil, fmt.Errorf("log output %s requires a file", output)
+		}
+		rotating := newRotatingFile(file, rotation)
+		if output == "file" {
+			return rotating, nil
+		}
+		return &teeWriter{Writer: io.MultiWriter(os.Stdout, rotating), closer: rotating}, nil
+	}
+	return nil, fmt.Errorf("unsupported log output %q", output)
+}
+
+// rotatingFile is a lumberjack file that is also rotated on an interval
+type rotatingFile struct {
+	*lumberjack.Logger
+	stop chan struct{}
+	once sync.Once
+}
+
+func newRotatingFile(file string, rotation Rotation) *rotatingFile {
+	f := &rotatingFile{
+		Logger: &lumberjack.Logger{
+			Filename:   file,
+			MaxSize:    rotation.MaxSizeMB,
+			MaxBackups: rotation.MaxBackups,
+			MaxAge:     rotation.MaxAgeDays,
+			Compress:   rotation.Compress,
+			LocalTime:  true,
+		},
+		stop: make(chan struct{}),
+	}
+	if rotation.Interval > 0 {
+		go f.rotateEvery(rotation.Interval)
+	}
+	return f
+}
+
+func (f *rotatingFile) rotateEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := f.Rotate(); err != nil {
+				fmt.Fprintf(os.Stderr, "failed to rotate log file %s: %v\n", f.Filename, err)
+			}
+		case <-f.stop:
+			return
+		}
+	}
+}
+
+func (f *rotatingFile) Close() error {
+	f.once.Do(func() { close(f.stop) })
+	return f.Logger.Close()
+}
+
+type teeWriter struct {
+	io.Writer
+	closer io.Closer
+}
+
+func (t *teeWriter) Close() error {
+	return t.closer.Close()
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
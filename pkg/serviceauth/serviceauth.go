@@ -0,0 +1,101 @@
+// Package serviceauth signs requests between internal services and the
+// gateway. A signature is an HMAC-SHA256 over the method, path, query,
+// timestamp, nonce, body hash and service name, keyed by a per-service secret
+package serviceauth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers carrying the service credentials
+const (
+	HeaderServiceName = "X-Service-Name"
+	HeaderTimestamp   = "X-Service-Timestamp" // Unix seconds
+	HeaderNonce       = "X-Service-Nonce"
+	HeaderKeyID       = "X-Service-Key-ID" // optional; selects the key during rotation
+	HeaderSignature   = "X-Service-Signature"
+)
+
+// Version prefixes signatures so the scheme can evolve
+const Version = "v1"
+
+// CanonicalRequest builds the string that is signed
+func CanonicalRequest(method, path, query string, timestamp int64, nonce, bodyHash, serviceName string) string {
+	return strings.Join([]string{
+		Version,
+		strings.ToUpper(method),
+		path,
+		query,
+		strconv.FormatInt(timestamp, 10),
+		nonce,
+		bodyHash,
+		serviceName,
+	}, "\n")
+}
+
+// BodyHash returns the hex SHA-256 of a request body
+func BodyHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// Signature returns the signature header value for a canonical request
+func Signature(secret []byte, canonical string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(canonical))
+	return Version + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignRequest adds service credentials to an outgoing request. The body is
+// read for hashing and replaced, so the request can still be sent
+func SignRequest(req *http.Request, serviceName, keyID string, secret []byte) error {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	nonce, err := newNonce()
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().Unix()
+
+	canonical := CanonicalRequest(req.Method, req.URL.EscapedPath(), req.URL.RawQuery,
+		timestamp, nonce, BodyHash(body), serviceName)
+
+	req.Header.Set(HeaderServiceName, serviceName)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderNonce, nonce)
+	if keyID != "" {
+		req.Header.Set(HeaderKeyID, keyID)
+	}
+	req.Header.Set(HeaderSignature, Signature(secret, canonical))
+	return nil
+}
+
+func newNonce() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}